@@ -0,0 +1,29 @@
+package benchmarks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/client"
+	"github.com/socialsister/espresso-sequencer/sdks/go/clienttest"
+)
+
+// BenchmarkClientFetchHeaderByHeight exercises Client.FetchHeaderByHeight
+// end to end - request encoding, the round trip, and response decoding -
+// against clienttest's in-memory MockServer, so the benchmark doesn't pay
+// for (or depend on the availability of) a live dev node.
+func BenchmarkClientFetchHeaderByHeight(b *testing.B) {
+	srv := clienttest.NewMockServer()
+	defer srv.Close()
+	srv.AddHeader(fixtureHeader.Height, fixtureHeader)
+
+	c := client.NewClient(srv.URL())
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.FetchHeaderByHeight(ctx, fixtureHeader.Height); err != nil {
+			b.Fatalf("fetch header: %v", err)
+		}
+	}
+}
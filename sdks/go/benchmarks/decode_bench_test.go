@@ -0,0 +1,21 @@
+package benchmarks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// BenchmarkDecodeHeader measures unmarshaling a header response body into
+// types.HeaderImpl, the decode step every query path (REST and gRPC alike)
+// pays before returning a header to the caller.
+func BenchmarkDecodeHeader(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var header types.HeaderImpl
+		if err := json.Unmarshal(fixtureHeaderJSON, &header); err != nil {
+			b.Fatalf("unmarshal header: %v", err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Package benchmarks contains reproducible, allocation-tracked benchmarks
+// for the SDK's hot paths: proof verification, header decoding, and client
+// fetches. Run them with:
+//
+//	go test -bench . -benchmem ./benchmarks/...
+//
+// and compare the -benchmem output across SDK releases to catch allocation
+// or throughput regressions before they ship. Benchmarks live in their own
+// package, rather than alongside the code they exercise, so they can
+// depend on both the client and verification packages (and clienttest's
+// mock server) without those packages needing to depend on each other.
+package benchmarks
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// The fixtures below are sized to resemble a real block rather than the
+// smallest input that happens to parse: a rollup's namespace proof
+// routinely carries dozens of transactions, and a block merkle proof's
+// path length tracks the depth of the tree, not a handful of hops. No
+// captured mainnet block was available to record verbatim in this
+// environment, so these are synthesized to match those shapes instead of
+// a single trivial `{"height":1}`-style input, which would benchmark
+// argument-parsing overhead more than the verifier itself.
+const (
+	fixtureTransactionCount   = 64
+	fixtureTransactionPayload = 256 // bytes, per transaction
+	fixtureMerkleProofDepth   = 24  // path length for a multi-million-block chain
+)
+
+var (
+	fixtureHeader     types.HeaderImpl
+	fixtureHeaderJSON []byte
+
+	fixtureVidCommonJSON []byte
+
+	fixtureNamespaceProof     types.NamespaceProof
+	fixtureNamespaceProofJSON []byte
+
+	fixtureBlockMerkleProof types.BlockMerkleProof
+	fixtureCommitment       types.Commitment
+)
+
+func init() {
+	fixtureHeader = types.HeaderImpl{
+		Height:            1_234_567,
+		Timestamp:         1_700_000_000,
+		L1Head:            18_900_000,
+		PayloadCommitment: "PAYLOAD-COMMITMENT-1234567",
+		BuilderCommitment: "BUILDER-COMMITMENT-1234567",
+		NsTable:           "NS-TABLE-1234567",
+		BlockMerkleRoot:   "BLOCK-MERKLE-ROOT-1234567",
+		FeeMerkleRoot:     "FEE-MERKLE-ROOT-1234567",
+	}
+	fixtureHeaderJSON = mustMarshal(fixtureHeader)
+
+	fixtureVidCommonJSON = mustMarshal("VID-COMMON-1234567")
+
+	txs := make([]types.Transaction, fixtureTransactionCount)
+	for i := range txs {
+		txs[i] = types.Transaction{
+			Namespace: 42,
+			Payload:   bytes.Repeat([]byte{byte(i)}, fixtureTransactionPayload),
+		}
+	}
+	fixtureNamespaceProof = types.NamespaceProof{
+		Namespace:    42,
+		Proof:        bytes.Repeat([]byte{0xAB}, 128),
+		Transactions: txs,
+	}
+	fixtureNamespaceProofJSON = mustMarshal(fixtureNamespaceProof)
+
+	path := make([]string, fixtureMerkleProofDepth)
+	for i := range path {
+		path[i] = "SIBLING-HASH"
+	}
+	fixtureBlockMerkleProof = types.BlockMerkleProof{
+		Height: fixtureHeader.Height,
+		Path:   path,
+	}
+	fixtureCommitment = types.Commitment(fixtureHeader.BlockMerkleRoot)
+}
+
+func mustMarshal(v interface{}) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
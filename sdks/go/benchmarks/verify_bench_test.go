@@ -0,0 +1,33 @@
+package benchmarks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/verification"
+)
+
+// BenchmarkVerifyNamespace exercises VerifyNamespace against a
+// real-world-sized namespace proof (see fixtures.go). Under the cgo build
+// this measures allocation overhead up to and including the native call;
+// under the purego and embedded builds it measures the structural
+// fallback check in full. Compare -benchmem output across releases and
+// across build tags to catch regressions in either.
+func BenchmarkVerifyNamespace(b *testing.B) {
+	ctx := context.Background()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = verification.VerifyNamespace(ctx, fixtureHeaderJSON, fixtureVidCommonJSON, fixtureNamespaceProofJSON)
+	}
+}
+
+// BenchmarkVerifyMerkleProof exercises VerifyMerkleProof against a
+// fixtureMerkleProofDepth-deep path, the shape a multi-million-block chain
+// actually produces rather than a one-hop toy proof.
+func BenchmarkVerifyMerkleProof(b *testing.B) {
+	ctx := context.Background()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = verification.VerifyMerkleProof(ctx, fixtureHeader, fixtureBlockMerkleProof, fixtureCommitment)
+	}
+}
@@ -0,0 +1,167 @@
+// Package builder is a Go client for the Espresso block builder's HTTP API
+// exposed under `builder/src/non_permissioned.rs` and
+// `builder/src/permissioned.rs`: querying available blocks, claiming a
+// block and its header input, looking up the builder's fee account, and
+// submitting transactions directly to its private mempool. The builder is
+// typically reached on the port named by ESPRESSO_BUILDER_PORT in a local
+// dev stack.
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// Client talks to a single block builder instance.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Option configures a Client constructed with NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the underlying http.Client.
+func WithHTTPClient(h *http.Client) Option {
+	return func(c *Client) { c.httpClient = h }
+}
+
+// NewClient constructs a Client for the builder at baseURL, e.g.
+// "http://localhost:5555".
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{baseURL: baseURL, httpClient: &http.Client{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AvailableBlockInfo describes one block the builder is offering, as
+// returned by the availableblocks endpoint.
+type AvailableBlockInfo struct {
+	BlockHash  string `json:"block_hash"`
+	BlockSize  uint64 `json:"block_size"`
+	OfferedFee string `json:"offered_fee"`
+}
+
+// AvailableBlockData is the full block body returned by claimblock.
+type AvailableBlockData struct {
+	BlockPayload []byte `json:"block_payload"`
+	Metadata     []byte `json:"metadata"`
+}
+
+// AvailableBlockHeaderInput is the header construction input returned by
+// claimheaderinput: the VID commitment and fee signature the sequencer
+// needs to assemble a header around the claimed block.
+type AvailableBlockHeaderInput struct {
+	VidCommitment string `json:"vid_commitment"`
+	Fee           string `json:"fee"`
+}
+
+// VidCommitmentTag decodes the VID commitment's tagged-base64 tag and
+// value, e.g. "VID~AAAA..." -> ("VID", []byte{...}). See
+// types.Commitment.Tag's doc comment for why this doesn't verify the
+// checksum.
+func (a AvailableBlockHeaderInput) VidCommitmentTag() (tag string, value []byte, err error) {
+	return types.ParseTaggedBase64(a.VidCommitment)
+}
+
+// AvailableBlocks returns the blocks the builder currently has available
+// for the given parent commitment, signed by the requesting HotShot node's
+// key as required by the builder API.
+func (c *Client) AvailableBlocks(ctx context.Context, parentCommitment, hotshotPubKey, encodedSignature string) ([]AvailableBlockInfo, error) {
+	var blocks []AvailableBlockInfo
+	path := fmt.Sprintf("block_info/availableblocks/%s/%s/%s", parentCommitment, hotshotPubKey, encodedSignature)
+	if err := c.get(ctx, path, &blocks); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// ClaimBlock claims the block identified by builderCommitment, which must
+// have previously been returned from AvailableBlocks.
+func (c *Client) ClaimBlock(ctx context.Context, builderCommitment, hotshotPubKey, encodedSignature string) (*AvailableBlockData, error) {
+	var data AvailableBlockData
+	path := fmt.Sprintf("block_info/claimblock/%s/%s/%s", builderCommitment, hotshotPubKey, encodedSignature)
+	if err := c.get(ctx, path, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// ClaimBlockHeaderInput claims the header construction input for the block
+// identified by builderCommitment.
+func (c *Client) ClaimBlockHeaderInput(ctx context.Context, builderCommitment, hotshotPubKey, encodedSignature string) (*AvailableBlockHeaderInput, error) {
+	var input AvailableBlockHeaderInput
+	path := fmt.Sprintf("block_info/claimheaderinput/%s/%s/%s", builderCommitment, hotshotPubKey, encodedSignature)
+	if err := c.get(ctx, path, &input); err != nil {
+		return nil, err
+	}
+	return &input, nil
+}
+
+// BuilderAddress returns the builder's fee account address, as a decimal or
+// hex-encoded string matching the sequencer's FeeAccount representation.
+func (c *Client) BuilderAddress(ctx context.Context) (string, error) {
+	var address string
+	if err := c.get(ctx, "block_info/builderaddress", &address); err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
+// SubmitTransaction submits tx directly to the builder's private mempool,
+// bypassing the sequencer's public submit API.
+func (c *Client) SubmitTransaction(ctx context.Context, tx types.Transaction) error {
+	body, err := json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("builder client: encode transaction: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/txn_submit/submit", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("builder client: submit transaction: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("builder client: submit transaction: unexpected status %d: %s", resp.StatusCode, snippet)
+	}
+	return nil
+}
+
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/"+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("builder client: GET %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("builder client: GET %s: unexpected status %d: %s", path, resp.StatusCode, snippet)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("builder client: GET %s: decode response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestAvailableBlocksAndSubmit(t *testing.T) {
+	var gotSubmit types.Transaction
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/block_info/availableblocks/p/k/s":
+			w.Write([]byte(`[{"block_hash":"abc","block_size":100,"offered_fee":"5"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/block_info/builderaddress":
+			w.Write([]byte(`"0xabc"`))
+		case r.Method == http.MethodPost && r.URL.Path == "/txn_submit/submit":
+			if err := json.NewDecoder(r.Body).Decode(&gotSubmit); err != nil {
+				t.Errorf("decode submit body: %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	blocks, err := c.AvailableBlocks(context.Background(), "p", "k", "s")
+	if err != nil {
+		t.Fatalf("AvailableBlocks: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].BlockHash != "abc" {
+		t.Fatalf("got %+v", blocks)
+	}
+
+	addr, err := c.BuilderAddress(context.Background())
+	if err != nil {
+		t.Fatalf("BuilderAddress: %v", err)
+	}
+	if addr != "0xabc" {
+		t.Fatalf("got %q", addr)
+	}
+
+	tx := types.Transaction{Namespace: 1, Payload: []byte{1, 2, 3}}
+	if err := c.SubmitTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("SubmitTransaction: %v", err)
+	}
+	if gotSubmit.Namespace != 1 {
+		t.Fatalf("got submitted namespace %d", gotSubmit.Namespace)
+	}
+}
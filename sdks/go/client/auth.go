@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RequestSigner signs or otherwise stamps an outgoing request in place,
+// e.g. to attach a per-request HMAC signature or a freshly minted token.
+// It runs after default headers are applied, so it can see or override
+// them.
+type RequestSigner func(req *http.Request) error
+
+// WithAPIKey adds header as a static API key header on every request, e.g.
+// WithAPIKey("X-API-Key", "...") for query services sitting behind an
+// authenticated gateway.
+func WithAPIKey(header, key string) Option {
+	return func(c *Client) {
+		c.defaultHeaders = cloneOrNewHeader(c.defaultHeaders)
+		c.defaultHeaders.Set(header, key)
+	}
+}
+
+// WithBearerToken sets a static Authorization: Bearer header on every
+// request. For tokens that expire or rotate, use WithRequestSigner instead.
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.defaultHeaders = cloneOrNewHeader(c.defaultHeaders)
+		c.defaultHeaders.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// WithRequestSigner installs a callback invoked on every outgoing request,
+// for credentials that must be computed per request, such as a signature
+// over the request path or a token refreshed from an external source.
+func WithRequestSigner(sign RequestSigner) Option {
+	return func(c *Client) { c.signer = sign }
+}
+
+func cloneOrNewHeader(h http.Header) http.Header {
+	if h == nil {
+		return http.Header{}
+	}
+	return h.Clone()
+}
+
+func (c *Client) sign(req *http.Request) error {
+	if c.signer == nil {
+		return nil
+	}
+	if err := c.signer(req); err != nil {
+		return fmt.Errorf("espresso client: sign request: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestWithBearerToken(t *testing.T) {
+	c := &Client{httpClient: &http.Client{}}
+	WithBearerToken("abc123")(c)
+
+	if got := c.defaultHeaders.Get("Authorization"); got != "Bearer abc123" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestWithAPIKey(t *testing.T) {
+	c := &Client{httpClient: &http.Client{}}
+	WithAPIKey("X-API-Key", "secret")(c)
+
+	if got := c.defaultHeaders.Get("X-API-Key"); got != "secret" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestSignPropagatesSignerError(t *testing.T) {
+	wantErr := errors.New("boom")
+	c := &Client{httpClient: &http.Client{}}
+	WithRequestSigner(func(req *http.Request) error { return wantErr })(c)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err := c.sign(req); !errors.Is(err, wantErr) {
+		t.Fatalf("got %v, want wrapped %v", err, wantErr)
+	}
+}
+
+func TestSignNoopWithoutSigner(t *testing.T) {
+	c := &Client{httpClient: &http.Client{}}
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err := c.sign(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
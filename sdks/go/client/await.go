@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// minAwaitPollInterval is the shortest interval AwaitBlockHeight polls at,
+// used once the chain is within one block of the target height.
+const minAwaitPollInterval = 50 * time.Millisecond
+
+// AwaitBlockHeight blocks until the chain reaches at least height, or until
+// ctx is cancelled. It polls FetchLatestBlockHeight with a short interval
+// that backs off while the chain is far from the target height and
+// tightens up as it gets close, so derivation pipelines don't have to
+// hard-code a fixed sleep per block.
+func (c *Client) AwaitBlockHeight(ctx context.Context, height uint64) error {
+	interval := minAwaitPollInterval
+	for {
+		latest, err := c.FetchLatestBlockHeight(ctx)
+		if err != nil {
+			return fmt.Errorf("await block height %d: %w", height, err)
+		}
+		if latest >= height {
+			return nil
+		}
+
+		// Poll more slowly the further away we are, down to a floor, so a
+		// caller awaiting a height far in the future doesn't spin.
+		interval = adaptiveInterval(height-latest, interval)
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func adaptiveInterval(remaining uint64, previous time.Duration) time.Duration {
+	switch {
+	case remaining <= 1:
+		return minAwaitPollInterval
+	case remaining <= 5:
+		return 200 * time.Millisecond
+	default:
+		return time.Second
+	}
+}
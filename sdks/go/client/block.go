@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// Block is the full availability-API view of a block: its header, payload,
+// VID common data, and a couple of derived convenience fields.
+type Block struct {
+	Header          *types.HeaderImpl `json:"header"`
+	Payload         []byte            `json:"payload"`
+	VidCommon       types.VidCommon   `json:"vid_common"`
+	Size            uint64            `json:"size"`
+	NumTransactions uint64            `json:"num_transactions"`
+}
+
+// FetchBlockByHeight returns the full block at height in a single call,
+// instead of requiring separate round-trips for the header, payload, and
+// VID common that the availability API already returns together.
+func (c *Client) FetchBlockByHeight(ctx context.Context, height uint64) (*Block, error) {
+	var block Block
+	if err := c.get(ctx, fmt.Sprintf("/availability/block/%d", height), &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// FetchVidCommonByHeight returns the VID common data for the block at
+// height, without fetching the rest of the block.
+func (c *Client) FetchVidCommonByHeight(ctx context.Context, height uint64) (types.VidCommon, error) {
+	var vidCommon types.VidCommon
+	if err := c.get(ctx, fmt.Sprintf("/availability/vid/common/%d", height), &vidCommon); err != nil {
+		return types.VidCommon{}, err
+	}
+	return vidCommon, nil
+}
+
+// FetchBlockByHash returns the full block with the given payload
+// commitment hash, for callers that have a hash on hand (e.g. from a
+// header) but not the block's height.
+func (c *Client) FetchBlockByHash(ctx context.Context, hash string) (*Block, error) {
+	var block Block
+	if err := c.get(ctx, fmt.Sprintf("/availability/block/hash/%s", hash), &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
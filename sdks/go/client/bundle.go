@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// BlockBundle groups the three pieces of data derivation code almost always
+// needs together for a given height: the header, the raw VID common data,
+// and the namespace table.
+type BlockBundle struct {
+	Header    *types.HeaderImpl
+	VidCommon types.VidCommon
+	NsTable   string
+}
+
+// FetchBlockBundle fetches a BlockBundle for height, issuing the header and
+// VID common requests concurrently. The namespace table is taken from the
+// fetched header, so it costs no request of its own.
+func (c *Client) FetchBlockBundle(ctx context.Context, height uint64) (*BlockBundle, error) {
+	var bundle BlockBundle
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		header, err := c.FetchHeaderByHeight(ctx, height)
+		if err != nil {
+			return err
+		}
+		bundle.Header = header
+		bundle.NsTable = header.NsTable
+		return nil
+	})
+	g.Go(func() error {
+		vidCommon, err := c.FetchVidCommonByHeight(ctx, height)
+		if err != nil {
+			return err
+		}
+		bundle.VidCommon = vidCommon
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
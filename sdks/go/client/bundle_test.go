@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchBlockBundle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/availability/header/"):
+			w.Write([]byte(`{
+				"height": 3,
+				"timestamp": 100,
+				"l1_head": 1,
+				"payload_commitment": "PAYLOAD~AAA",
+				"builder_commitment": "BUILDER~AAA",
+				"ns_table": "deadbeef",
+				"block_merkle_tree_root": "BLOCK~AAA",
+				"fee_merkle_tree_root": "FEE~AAA"
+			}`))
+		case strings.HasPrefix(r.URL.Path, "/availability/vid/common/"):
+			w.Write([]byte(`"AQID"`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	bundle, err := c.FetchBlockBundle(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("FetchBlockBundle: %v", err)
+	}
+	if bundle.Header.Height != 3 {
+		t.Fatalf("got header height %d", bundle.Header.Height)
+	}
+	if bundle.NsTable != "deadbeef" {
+		t.Fatalf("got ns table %q", bundle.NsTable)
+	}
+	if string(bundle.VidCommon.Raw) != "\x01\x02\x03" {
+		t.Fatalf("got vid common %v", bundle.VidCommon.Raw)
+	}
+}
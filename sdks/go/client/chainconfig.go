@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FetchChainConfig returns the full chain configuration in effect at
+// height, via the catchup API.
+func (c *Client) FetchChainConfig(ctx context.Context, height uint64) (*types.ChainConfig, error) {
+	var cfg types.ChainConfig
+	if err := c.get(ctx, fmt.Sprintf("/catchup/%d/chain-config", height), &cfg); err != nil {
+		return nil, fmt.Errorf("fetch chain config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// ResolveChainConfig returns rcc's ChainConfig, fetching it via
+// FetchChainConfig if the header at height only embedded a commitment to
+// it rather than the full value.
+func (c *Client) ResolveChainConfig(ctx context.Context, height uint64, rcc types.ResolvableChainConfig) (*types.ChainConfig, error) {
+	if cfg, ok := rcc.Resolve(); ok {
+		return cfg, nil
+	}
+	return c.FetchChainConfig(ctx, height)
+}
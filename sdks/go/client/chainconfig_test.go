@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchChainConfig(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/catchup/3/chain-config") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"chain_id": "35353", "max_block_size": 10240, "base_fee": "0"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	cfg, err := c.FetchChainConfig(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("FetchChainConfig: %v", err)
+	}
+	if cfg.MaxBlockSize != 10240 {
+		t.Fatalf("got max block size %d, want 10240", cfg.MaxBlockSize)
+	}
+	if v, ok := cfg.ChainID.Uint64(); !ok || v != 35353 {
+		t.Fatalf("got chain id %v, want 35353", cfg.ChainID)
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned when a request is rejected without being sent
+// because its target URL's circuit breaker is open.
+var ErrCircuitOpen = errors.New("espresso client: circuit open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// breaker tracks consecutive failures for a single URL and trips once they
+// reach the registry's threshold, rejecting further requests until cooldown
+// has passed and a probe request succeeds.
+type breaker struct {
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// circuitBreakerRegistry holds one breaker per query-service URL, so a slow
+// or dead hedge target is ejected instead of making every caller pay its
+// timeout on every request.
+type circuitBreakerRegistry struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+func newCircuitBreakerRegistry(threshold int, cooldown time.Duration) *circuitBreakerRegistry {
+	return &circuitBreakerRegistry{
+		threshold: threshold,
+		cooldown:  cooldown,
+		breakers:  make(map[string]*breaker),
+	}
+}
+
+func (r *circuitBreakerRegistry) breakerFor(url string) *breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.breakers[url]
+	if !ok {
+		b = &breaker{}
+		r.breakers[url] = b
+	}
+	return b
+}
+
+// allow reports whether a request to url may proceed. An open breaker whose
+// cooldown has elapsed transitions to half-open and allows exactly one probe
+// through; concurrent callers during that window are rejected so only one
+// probe is in flight at a time.
+func (r *circuitBreakerRegistry) allow(url string) bool {
+	b := r.breakerFor(url)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if time.Since(b.openedAt) < r.cooldown {
+			return false
+		}
+		b.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// recordResult updates url's breaker after a request completes. A successful
+// probe from half-open closes the circuit; a failure anywhere reopens it
+// (extending the cooldown from now) once failures reach the threshold.
+func (r *circuitBreakerRegistry) recordResult(url string, err error) {
+	b := r.breakerFor(url)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil {
+		b.failures = 0
+		b.state = circuitClosed
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= r.threshold {
+		b.state = circuitOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// WithCircuitBreaker ejects a query-service URL from hedged requests after
+// threshold consecutive failures, rejecting further requests to it until
+// cooldown has passed and a single probe request succeeds.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) { c.breakers = newCircuitBreakerRegistry(threshold, cooldown) }
+}
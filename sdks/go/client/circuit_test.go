@@ -0,0 +1,49 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsAndRejects(t *testing.T) {
+	r := newCircuitBreakerRegistry(2, time.Minute)
+	const url = "http://dead.example"
+
+	if !r.allow(url) {
+		t.Fatal("expected first request to be allowed")
+	}
+	r.recordResult(url, errors.New("boom"))
+	if !r.allow(url) {
+		t.Fatal("expected request to be allowed below threshold")
+	}
+	r.recordResult(url, errors.New("boom"))
+
+	if r.allow(url) {
+		t.Fatal("expected breaker to be open after reaching threshold")
+	}
+}
+
+func TestCircuitBreakerHalfOpenProbeRecovers(t *testing.T) {
+	r := newCircuitBreakerRegistry(1, time.Millisecond)
+	const url = "http://flaky.example"
+
+	r.allow(url)
+	r.recordResult(url, errors.New("boom"))
+	if r.allow(url) {
+		t.Fatal("expected breaker to reject immediately after tripping")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if !r.allow(url) {
+		t.Fatal("expected cooldown to elapse into a half-open probe")
+	}
+	if r.allow(url) {
+		t.Fatal("expected a second concurrent call during half-open to be rejected")
+	}
+
+	r.recordResult(url, nil)
+	if !r.allow(url) {
+		t.Fatal("expected breaker to close after a successful probe")
+	}
+}
@@ -0,0 +1,227 @@
+// Package client is a Go SDK for the Espresso Sequencer's query and submit
+// APIs. It wraps the HTTP endpoints documented under `sequencer/api/` with a
+// typed, idiomatic client.
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// maxErrorBodySnippet bounds how much of an error response body APIError
+// retains, so a misbehaving server can't balloon memory via error messages.
+const maxErrorBodySnippet = 2048
+
+// Client talks to a single Espresso Sequencer query service.
+type Client struct {
+	baseURL          string
+	httpClient       *http.Client
+	codec            *Registry
+	retryPolicy      RetryPolicy
+	defaultHeaders   http.Header
+	rateLimiter      *tokenBucket
+	metrics          MetricsRecorder
+	tracer           trace.Tracer
+	logger           Logger
+	cache            *lruCache
+	grpc             *grpcTransport
+	grpcDialErr      error
+	maxResponseBytes int64
+	apiVersion       APIVersion
+	signer           RequestSigner
+	sf               *singleflight.Group
+	hedgeDelay       time.Duration
+	hedgeURLs        []string
+	breakers         *circuitBreakerRegistry
+	methodTimeouts   map[string]time.Duration
+}
+
+// NewClient constructs a Client for the query service at baseURL, e.g.
+// "https://query.sequencer.espresso.network". Pass Option values to
+// customize the underlying transport, timeouts, headers, or retry policy.
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    baseURL,
+		httpClient: &http.Client{},
+		codec:      DefaultRegistry(),
+		logger:     nopLogger{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) applyDefaultHeaders(req *http.Request) {
+	for k, values := range c.defaultHeaders {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", userAgent())
+	}
+}
+
+// FetchLatestBlockHeight returns the height of the most recent block known to
+// the query service.
+func (c *Client) FetchLatestBlockHeight(ctx context.Context) (uint64, error) {
+	if c.grpc != nil {
+		return c.fetchLatestBlockHeightGRPC(ctx)
+	}
+	var height uint64
+	if err := c.get(ctx, "/status/block-height", &height); err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// FetchHeaderByHeight returns the header for the block at the given height.
+func (c *Client) FetchHeaderByHeight(ctx context.Context, height uint64) (*types.HeaderImpl, error) {
+	var header types.HeaderImpl
+	if c.grpc != nil {
+		resp, err := c.fetchHeaderByHeightGRPC(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+		if err := decodeHeaderJSON(resp.HeaderJson, &header); err != nil {
+			return nil, fmt.Errorf("espresso client: decode grpc header: %w", err)
+		}
+		return &header, nil
+	}
+	if err := c.get(ctx, fmt.Sprintf("/availability/header/%d", height), &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// FetchHeaderByHash returns the header with the given payload commitment
+// hash, for callers that have a hash on hand but not the block's height.
+func (c *Client) FetchHeaderByHash(ctx context.Context, hash string) (*types.HeaderImpl, error) {
+	var header types.HeaderImpl
+	if err := c.get(ctx, fmt.Sprintf("/availability/header/hash/%s", hash), &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// get performs a GET request, retrying according to the client's
+// RetryPolicy since GET requests against this API are always idempotent.
+// Hedged requests (see WithHedging) are not retried.
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	ctx, cancel := c.withMethodTimeout(ctx, path)
+	defer cancel()
+
+	if len(c.hedgeURLs) > 0 {
+		return c.getHedged(ctx, path, out)
+	}
+	if c.sf != nil {
+		return c.getCoalesced(ctx, path, out)
+	}
+	return c.withRetry(ctx, func() error {
+		return c.getOnce(ctx, path, out)
+	})
+}
+
+func (c *Client) getOnce(ctx context.Context, path string, out interface{}) (err error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	ctx, finishSpan := c.startSpan(ctx, path)
+	defer func() { finishSpan(err) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+c.resolvePath(path), nil)
+	if err != nil {
+		return err
+	}
+	codec := c.codec.Negotiate(req)
+
+	cacheable := c.cache != nil && isCacheablePath(path)
+	if cacheable {
+		if cached, ok := c.cache.get(path); ok {
+			return codec.Decode(bytes.NewReader(cached), out)
+		}
+	}
+
+	start := time.Now()
+	req.Header.Set("Accept", codec.ContentType())
+	c.setAcceptEncoding(req)
+	c.applyDefaultHeaders(req)
+	c.injectTraceHeaders(ctx, req)
+	if err := c.sign(req); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.recordMetrics(path, 0, start, 0)
+		return fmt.Errorf("espresso client: GET %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	decoded, err := decodeResponseBody(resp)
+	if err != nil {
+		c.recordMetrics(path, resp.StatusCode, start, 0)
+		return err
+	}
+	defer decoded.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(decoded, maxErrorBodySnippet))
+		c.recordMetrics(path, resp.StatusCode, start, len(body))
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Endpoint:   path,
+			Body:       string(body),
+			RequestID:  resp.Header.Get("X-Request-Id"),
+			RetryAfter: retryAfterDuration(resp),
+		}
+	}
+
+	// Cached entries need to be materialized as bytes anyway, so there is no
+	// streaming benefit there. Everything else is decoded straight off the
+	// wire, capped by maxResponseBytes, so large payloads and header ranges
+	// never get fully buffered in memory just to be parsed.
+	if cacheable {
+		body, err := io.ReadAll(c.limitBody(decoded))
+		if err != nil {
+			c.recordMetrics(path, resp.StatusCode, start, 0)
+			return fmt.Errorf("espresso client: GET %s: read body: %w", path, err)
+		}
+		c.recordMetrics(path, resp.StatusCode, start, len(body))
+		c.cache.put(path, body)
+		if err := codec.Decode(bytes.NewReader(body), out); err != nil {
+			return newDecodeError(path, body, err)
+		}
+		return nil
+	}
+
+	counting := &countingReader{r: c.limitBody(decoded)}
+	if err := codec.Decode(counting, out); err != nil {
+		c.recordMetrics(path, resp.StatusCode, start, counting.n)
+		return newDecodeError(path, counting.snippet.Bytes(), err)
+	}
+	c.recordMetrics(path, resp.StatusCode, start, counting.n)
+	return nil
+}
+
+// isCacheablePath reports whether responses for path are immutable once
+// observed, and therefore safe to memoize in the LRU query cache. Endpoints
+// that can change over time, like the latest block height, must not be
+// cached.
+func isCacheablePath(path string) bool {
+	return strings.HasPrefix(path, "/availability/")
+}
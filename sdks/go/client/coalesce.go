@@ -0,0 +1,137 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// WithRequestCoalescing deduplicates concurrent identical GET requests: if
+// multiple goroutines ask for the same path at once, only one HTTP request
+// is made and every caller decodes its own copy of the shared response.
+// Parallel derivation workers otherwise multiply identical header and VID
+// common lookups against the query service.
+//
+// Coalesced requests are fully buffered before decoding, so they do not
+// benefit from the streaming decode used by the uncoalesced path.
+func WithRequestCoalescing() Option {
+	return func(c *Client) { c.sf = &singleflight.Group{} }
+}
+
+// getCoalesced is the singleflight-backed counterpart to get: it shares one
+// in-flight HTTP request (including its retries) across all callers
+// currently asking for path.
+func (c *Client) getCoalesced(ctx context.Context, path string, out interface{}) error {
+	v, err, _ := c.sf.Do(path, func() (interface{}, error) {
+		var body []byte
+		err := c.withRetry(ctx, func() error {
+			b, fetchErr := c.fetchBytes(ctx, path)
+			if fetchErr != nil {
+				return fetchErr
+			}
+			body = b
+			return nil
+		})
+		return body, err
+	})
+	if err != nil {
+		return err
+	}
+
+	body := v.([]byte)
+	if err := c.codec.Codec("").Decode(bytes.NewReader(body), out); err != nil {
+		return newDecodeError(path, body, err)
+	}
+	return nil
+}
+
+// fetchBytes performs a single GET request for path against the client's
+// configured baseURL and returns its fully decompressed, size-limited body,
+// without decoding it. It exists alongside getOnce's streaming decode
+// specifically for getCoalesced, which needs the same bytes handed to every
+// waiting caller.
+func (c *Client) fetchBytes(ctx context.Context, path string) ([]byte, error) {
+	return c.fetchBytesFrom(ctx, c.baseURL, path)
+}
+
+// fetchBytesFrom is fetchBytes against an arbitrary baseURL, for callers
+// like the hedging transport that need to race requests across endpoints.
+func (c *Client) fetchBytesFrom(ctx context.Context, baseURL, path string) ([]byte, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.breakers != nil {
+		if !c.breakers.allow(baseURL) {
+			return nil, ErrCircuitOpen
+		}
+		var err error
+		defer func() { c.breakers.recordResult(baseURL, err) }()
+		body, fetchErr := c.fetchBytesFromOnce(ctx, baseURL, path)
+		err = fetchErr
+		return body, fetchErr
+	}
+
+	return c.fetchBytesFromOnce(ctx, baseURL, path)
+}
+
+// fetchBytesFromOnce is the actual request logic behind fetchBytesFrom,
+// split out so the circuit breaker bookkeeping above it can record success
+// or failure without duplicating the request itself.
+func (c *Client) fetchBytesFromOnce(ctx context.Context, baseURL, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+c.resolvePath(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	codec := c.codec.Negotiate(req)
+
+	start := time.Now()
+	req.Header.Set("Accept", codec.ContentType())
+	c.setAcceptEncoding(req)
+	c.applyDefaultHeaders(req)
+	c.injectTraceHeaders(ctx, req)
+	if err := c.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.recordMetrics(path, 0, start, 0)
+		return nil, fmt.Errorf("espresso client: GET %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	decoded, err := decodeResponseBody(resp)
+	if err != nil {
+		c.recordMetrics(path, resp.StatusCode, start, 0)
+		return nil, err
+	}
+	defer decoded.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(decoded, maxErrorBodySnippet))
+		c.recordMetrics(path, resp.StatusCode, start, len(body))
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Endpoint:   path,
+			Body:       string(body),
+			RequestID:  resp.Header.Get("X-Request-Id"),
+			RetryAfter: retryAfterDuration(resp),
+		}
+	}
+
+	body, err := io.ReadAll(c.limitBody(decoded))
+	if err != nil {
+		c.recordMetrics(path, resp.StatusCode, start, 0)
+		return nil, fmt.Errorf("espresso client: GET %s: read body: %w", path, err)
+	}
+	c.recordMetrics(path, resp.StatusCode, start, len(body))
+	return body, nil
+}
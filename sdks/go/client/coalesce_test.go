@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetCoalescedDeduplicatesConcurrentRequests(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`42`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithRequestCoalescing())
+
+	var wg sync.WaitGroup
+	results := make([]uint64, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var height uint64
+			if err := c.get(context.Background(), "/status/block-height", &height); err != nil {
+				t.Errorf("get: %v", err)
+				return
+			}
+			results[i] = height
+		}(i)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Fatalf("expected exactly 1 upstream request, got %d", got)
+	}
+	for _, r := range results {
+		if r != 42 {
+			t.Fatalf("expected every caller to see 42, got %d", r)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Codec encodes and decodes request/response bodies for a single wire
+// format. The client ships with JSON support out of the box; CBOR and
+// bincode codecs can be registered by callers that need them without
+// changing the Client API.
+type Codec interface {
+	// Name identifies the codec for registration and negotiation, e.g.
+	// "json", "cbor", "bincode".
+	Name() string
+	// ContentType is the value sent in the Accept and Content-Type headers.
+	ContentType() string
+	Encode(v interface{}) ([]byte, error)
+	Decode(r io.Reader, v interface{}) error
+}
+
+// Registry selects a Codec per request. Selection uses the registry's
+// Default; the server's response headers are not consulted (see Negotiate).
+type Registry struct {
+	codecs  map[string]Codec
+	order   []string
+	Default string
+}
+
+// DefaultRegistry returns a Registry with only JSON registered, which is the
+// format every query service supports today.
+func DefaultRegistry() *Registry {
+	r := &Registry{codecs: map[string]Codec{}, Default: "json"}
+	r.Register(jsonCodec{})
+	return r
+}
+
+// Register adds or replaces a codec. The first codec registered becomes the
+// default until Default is set explicitly.
+func (r *Registry) Register(c Codec) {
+	if r.codecs == nil {
+		r.codecs = map[string]Codec{}
+	}
+	if _, ok := r.codecs[c.Name()]; !ok {
+		r.order = append(r.order, c.Name())
+	}
+	if r.Default == "" {
+		r.Default = c.Name()
+	}
+	r.codecs[c.Name()] = c
+}
+
+// Codec looks up a registered codec by name, falling back to the default.
+func (r *Registry) Codec(name string) Codec {
+	if c, ok := r.codecs[name]; ok {
+		return c
+	}
+	return r.codecs[r.Default]
+}
+
+// Negotiate picks the codec to use for req, preferring the first registered
+// codec supported by both the client and the server. Since there is no
+// server round trip yet at request-construction time, negotiation currently
+// just honors the registry's configured default; it exists as the single
+// place request formats are decided so a real handshake can be added later
+// without touching call sites.
+func (r *Registry) Negotiate(req *http.Request) Codec {
+	return r.Codec(r.Default)
+}
+
+// SetStrict toggles DisallowUnknownFields on the registered JSON codec. In
+// strict mode, a response containing a field the SDK's types don't know
+// about fails loudly instead of silently dropping it, which has masked
+// header schema changes in the past.
+func (r *Registry) SetStrict(strict bool) {
+	r.Register(jsonCodec{strict: strict})
+}
+
+type jsonCodec struct{ strict bool }
+
+func (jsonCodec) Name() string        { return "json" }
+func (jsonCodec) ContentType() string { return "application/json" }
+func (jsonCodec) Encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+func (j jsonCodec) Decode(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	if j.strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("json codec: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRegistryDefaultIsJSON(t *testing.T) {
+	r := DefaultRegistry()
+	codec := r.Codec("")
+	if codec.Name() != "json" {
+		t.Fatalf("expected default codec json, got %s", codec.Name())
+	}
+}
+
+func TestRegistryRegisterOverride(t *testing.T) {
+	r := DefaultRegistry()
+	r.Register(jsonCodec{})
+	if len(r.order) != 1 {
+		t.Fatalf("re-registering an existing codec should not duplicate it, got order %v", r.order)
+	}
+}
+
+func TestJSONCodecRoundTrip(t *testing.T) {
+	c := jsonCodec{}
+	b, err := c.Encode(map[string]int{"height": 42})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var out map[string]int
+	if err := c.Decode(bytes.NewReader(b), &out); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if out["height"] != 42 {
+		t.Fatalf("expected 42, got %d", out["height"])
+	}
+}
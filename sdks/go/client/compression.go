@@ -0,0 +1,59 @@
+package client
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Go's http.Transport transparently requests and decodes gzip responses,
+// but only as long as the caller never sets Accept-Encoding itself. Setting
+// it explicitly here lets the SDK also support deflate, which Go's
+// transport does not handle automatically, for block payload and
+// header-range queries that can be hundreds of KB.
+const acceptEncodingHeader = "gzip, deflate"
+
+func (c *Client) setAcceptEncoding(req *http.Request) {
+	req.Header.Set("Accept-Encoding", acceptEncodingHeader)
+}
+
+// decodeResponseBody wraps resp.Body to transparently decompress it
+// according to its Content-Encoding header, if any.
+func decodeResponseBody(resp *http.Response) (io.ReadCloser, error) {
+	switch resp.Header.Get("Content-Encoding") {
+	case "", "identity":
+		return resp.Body, nil
+	case "gzip":
+		r, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("espresso client: gzip response: %w", err)
+		}
+		return wrapReadCloser(r, resp.Body), nil
+	case "deflate":
+		r := flate.NewReader(resp.Body)
+		return wrapReadCloser(r, resp.Body), nil
+	default:
+		return resp.Body, nil
+	}
+}
+
+// wrapReadCloser returns a ReadCloser that reads from decoder but closes
+// both decoder and the underlying response body.
+func wrapReadCloser(decoder io.ReadCloser, body io.Closer) io.ReadCloser {
+	return &multiCloser{ReadCloser: decoder, extra: body}
+}
+
+type multiCloser struct {
+	io.ReadCloser
+	extra io.Closer
+}
+
+func (m *multiCloser) Close() error {
+	err := m.ReadCloser.Close()
+	if extraErr := m.extra.Close(); err == nil {
+		err = extraErr
+	}
+	return err
+}
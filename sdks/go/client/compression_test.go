@@ -0,0 +1,60 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestDecodeResponseBodyGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write([]byte(`{"height":42}`)); err != nil {
+		t.Fatalf("write gzip body: %v", err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatalf("close gzip writer: %v", err)
+	}
+
+	resp := &http.Response{
+		Header: http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:   io.NopCloser(&buf),
+	}
+
+	decoded, err := decodeResponseBody(resp)
+	if err != nil {
+		t.Fatalf("decodeResponseBody: %v", err)
+	}
+	defer decoded.Close()
+
+	got, err := io.ReadAll(decoded)
+	if err != nil {
+		t.Fatalf("read decoded body: %v", err)
+	}
+	if string(got) != `{"height":42}` {
+		t.Fatalf("got %q, want %q", got, `{"height":42}`)
+	}
+}
+
+func TestDecodeResponseBodyIdentity(t *testing.T) {
+	resp := &http.Response{
+		Header: http.Header{},
+		Body:   io.NopCloser(bytes.NewReader([]byte("raw"))),
+	}
+
+	decoded, err := decodeResponseBody(resp)
+	if err != nil {
+		t.Fatalf("decodeResponseBody: %v", err)
+	}
+	defer decoded.Close()
+
+	got, err := io.ReadAll(decoded)
+	if err != nil {
+		t.Fatalf("read decoded body: %v", err)
+	}
+	if string(got) != "raw" {
+		t.Fatalf("got %q, want %q", got, "raw")
+	}
+}
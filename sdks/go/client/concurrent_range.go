@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FetchHeadersByRangeConcurrent is like FetchHeadersByRange but fans chunk
+// requests for [from, to) out across up to workers goroutines, returning
+// results in height order. Backfilling a large range through the serial
+// API can take hours; this lets callers trade query-service load for wall
+// clock time.
+func (c *Client) FetchHeadersByRangeConcurrent(ctx context.Context, from, to uint64, workers int) ([]*types.HeaderImpl, error) {
+	chunks := collectRanges(chunkRange(from, to, maxHeadersPerRequest))
+	results := make([][]*types.HeaderImpl, len(chunks))
+
+	if err := fetchRangesConcurrent(ctx, chunks, workers, func(ctx context.Context, i int, r heightRange) error {
+		headers, err := c.fetchHeaderChunk(ctx, r.from, r.to)
+		if err != nil {
+			return err
+		}
+		results[i] = headers
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var out []*types.HeaderImpl
+	for _, headers := range results {
+		out = append(out, headers...)
+	}
+	return out, nil
+}
+
+// FetchVidCommonByRangeConcurrent fetches VID common data for every height
+// in [from, to) across up to workers goroutines, returning results indexed
+// by height - from.
+func (c *Client) FetchVidCommonByRangeConcurrent(ctx context.Context, from, to uint64, workers int) ([]types.VidCommon, error) {
+	if to < from {
+		to = from
+	}
+	n := to - from
+	heights := make([]heightRange, n)
+	for i := range heights {
+		h := from + uint64(i)
+		heights[i] = heightRange{from: h, to: h}
+	}
+	results := make([]types.VidCommon, n)
+
+	if err := fetchRangesConcurrent(ctx, heights, workers, func(ctx context.Context, i int, r heightRange) error {
+		vidCommon, err := c.FetchVidCommonByHeight(ctx, r.from)
+		if err != nil {
+			return err
+		}
+		results[i] = vidCommon
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// fetchRangesConcurrent runs fetch for every range in ranges using up to
+// workers goroutines, stopping at the first error.
+func fetchRangesConcurrent(ctx context.Context, ranges []heightRange, workers int, fetch func(ctx context.Context, i int, r heightRange) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+
+	for i, r := range ranges {
+		i, r := i, r
+		g.Go(func() error {
+			return fetch(ctx, i, r)
+		})
+	}
+	return g.Wait()
+}
+
+// collectRanges materializes a chunkRange iterator into a slice so its
+// chunks can be fanned out across workers instead of processed serially.
+func collectRanges(seq func(func(heightRange) bool)) []heightRange {
+	var out []heightRange
+	seq(func(r heightRange) bool {
+		out = append(out, r)
+		return true
+	})
+	return out
+}
@@ -0,0 +1,16 @@
+package client
+
+import "testing"
+
+func TestCollectRanges(t *testing.T) {
+	got := collectRanges(chunkRange(0, 1200, 500))
+	want := []heightRange{{0, 500}, {500, 1000}, {1000, 1200}}
+	if len(got) != len(want) {
+		t.Fatalf("got %d ranges, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("range %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import "fmt"
+
+// DecodeError wraps a failure to decode a response body, recording which
+// endpoint produced it and a truncated snippet of the offending body so the
+// failure is debuggable without re-running the request against a node that
+// may have already moved on. Schema drift between the query service and an
+// older SDK version has silently dropped fields in the past; this makes that
+// failure loud instead of quiet.
+type DecodeError struct {
+	Endpoint string
+	Body     string
+	Err      error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("espresso client: decode response from %s: %v (body: %s)", e.Endpoint, e.Err, e.Body)
+}
+
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+// newDecodeError truncates body to maxErrorBodySnippet bytes before
+// attaching it to the error, for the same reason APIError bounds its body.
+func newDecodeError(endpoint string, body []byte, err error) *DecodeError {
+	snippet := body
+	if len(snippet) > maxErrorBodySnippet {
+		snippet = snippet[:maxErrorBodySnippet]
+	}
+	return &DecodeError{Endpoint: endpoint, Body: string(snippet), Err: err}
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestJSONCodecStrictRejectsUnknownFields(t *testing.T) {
+	var out struct {
+		Height uint64 `json:"height"`
+	}
+
+	lenient := jsonCodec{}
+	if err := lenient.Decode(strings.NewReader(`{"height":1,"extra":true}`), &out); err != nil {
+		t.Fatalf("lenient decode should ignore unknown fields, got %v", err)
+	}
+
+	strict := jsonCodec{strict: true}
+	err := strict.Decode(strings.NewReader(`{"height":1,"extra":true}`), &out)
+	if err == nil {
+		t.Fatal("strict decode should reject unknown fields")
+	}
+}
+
+func TestNewDecodeErrorTruncatesBody(t *testing.T) {
+	body := bytes.Repeat([]byte("a"), maxErrorBodySnippet*2)
+	decodeErr := &DecodeError{}
+	err := newDecodeError("/availability/header/1", body, decodeErr)
+
+	if len(err.Body) != maxErrorBodySnippet {
+		t.Fatalf("got body snippet of length %d, want %d", len(err.Body), maxErrorBodySnippet)
+	}
+	if err.Endpoint != "/availability/header/1" {
+		t.Fatalf("got endpoint %q", err.Endpoint)
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FetchCurrentEpoch returns the epoch number the chain is currently in.
+func (c *Client) FetchCurrentEpoch(ctx context.Context) (uint64, error) {
+	var epoch uint64
+	if err := c.get(ctx, "/node/epoch", &epoch); err != nil {
+		return 0, err
+	}
+	return epoch, nil
+}
+
+// FetchEpochInfo returns the height boundaries of the given epoch.
+func (c *Client) FetchEpochInfo(ctx context.Context, epoch uint64) (*types.EpochInfo, error) {
+	var info types.EpochInfo
+	if err := c.get(ctx, fmt.Sprintf("/node/epoch/%d", epoch), &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// FetchRewardAccount returns the reward balance for address.
+func (c *Client) FetchRewardAccount(ctx context.Context, address string) (*types.RewardAccountState, error) {
+	var state types.RewardAccountState
+	if err := c.get(ctx, fmt.Sprintf("/node/reward-state/%s", address), &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Sentinel errors for common API failure modes, so callers can check for
+// them with errors.Is instead of comparing APIError.StatusCode directly.
+var (
+	ErrNotFound        = errors.New("espresso client: not found")
+	ErrRateLimited     = errors.New("espresso client: rate limited")
+	ErrPayloadTooLarge = errors.New("espresso client: payload too large")
+)
+
+// APIError is returned by Client methods when the query service responds
+// with a non-2xx status. It carries enough context to debug the failure
+// without re-issuing the request, and wraps cleanly so callers can use
+// errors.As to recover it from a higher-level error.
+type APIError struct {
+	// StatusCode is the HTTP status returned by the server.
+	StatusCode int
+	// Endpoint is the request path that failed, e.g. "/availability/header/42".
+	Endpoint string
+	// Body is a truncated snippet of the response body, for logging.
+	Body string
+	// RequestID is the value of the X-Request-Id response header, if the
+	// server set one.
+	RequestID string
+	// RetryAfter is parsed from the response's Retry-After header, if
+	// present. withRetry honors it instead of its own backoff schedule.
+	RetryAfter time.Duration
+}
+
+// Unwrap lets callers match common status codes with errors.Is against the
+// package's sentinel errors, without needing errors.As plus a status code
+// switch at every call site.
+func (e *APIError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusRequestEntityTooLarge:
+		return ErrPayloadTooLarge
+	default:
+		return nil
+	}
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("espresso client: %s: status %d (request %s): %s", e.Endpoint, e.StatusCode, e.RequestID, e.Body)
+	}
+	return fmt.Sprintf("espresso client: %s: status %d: %s", e.Endpoint, e.StatusCode, e.Body)
+}
+
+// retryAfterDuration parses a response's Retry-After header, which may be
+// either a number of seconds or an HTTP date, returning zero if absent or
+// unparseable.
+func retryAfterDuration(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// Retryable reports whether the request that produced this error is safe to
+// retry unmodified: server errors and rate limiting, but not client errors
+// like 400 or 404.
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case 408, 425, 429, 500, 502, 503, 504:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIErrorRetryable(t *testing.T) {
+	cases := map[int]bool{
+		404: false,
+		400: false,
+		429: true,
+		502: true,
+		503: true,
+	}
+	for status, want := range cases {
+		e := &APIError{StatusCode: status}
+		if got := e.Retryable(); got != want {
+			t.Errorf("status %d: Retryable() = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	cases := map[int]error{
+		404: ErrNotFound,
+		429: ErrRateLimited,
+		413: ErrPayloadTooLarge,
+	}
+	for status, want := range cases {
+		e := &APIError{StatusCode: status}
+		if !errors.Is(e, want) {
+			t.Errorf("status %d: expected errors.Is to match %v", status, want)
+		}
+	}
+
+	e := &APIError{StatusCode: 500}
+	if errors.Is(e, ErrNotFound) {
+		t.Error("500 should not match ErrNotFound")
+	}
+}
+
+func TestRetryAfterDurationSeconds(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if got := retryAfterDuration(resp); got != 2*time.Second {
+		t.Fatalf("got %v, want 2s", got)
+	}
+}
+
+func TestRetryAfterDurationAbsent(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	if got := retryAfterDuration(resp); got != 0 {
+		t.Fatalf("got %v, want 0", got)
+	}
+}
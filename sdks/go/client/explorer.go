@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// BlockSummary is a lightweight view of a block for explorer/dashboard use,
+// without the full payload.
+type BlockSummary struct {
+	Height          uint64 `json:"height"`
+	Hash            string `json:"hash"`
+	Timestamp       uint64 `json:"timestamp"`
+	NumTransactions uint64 `json:"num_transactions"`
+	Size            uint64 `json:"size"`
+}
+
+// TransactionSummary is a lightweight view of a transaction for explorer use.
+type TransactionSummary struct {
+	Hash      string            `json:"hash"`
+	Height    uint64            `json:"height"`
+	Namespace types.NamespaceId `json:"namespace"`
+	Index     uint64            `json:"index"`
+}
+
+// FetchBlockSummaries returns summaries for blocks in [from, to).
+func (c *Client) FetchBlockSummaries(ctx context.Context, from, to uint64) ([]BlockSummary, error) {
+	var summaries []BlockSummary
+	if err := c.get(ctx, fmt.Sprintf("/explorer/blocks/%d/%d", from, to), &summaries); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// FetchTransactionSummaries returns summaries for transactions in the block
+// at height.
+func (c *Client) FetchTransactionSummaries(ctx context.Context, height uint64) ([]TransactionSummary, error) {
+	var summaries []TransactionSummary
+	if err := c.get(ctx, fmt.Sprintf("/explorer/block/%d/transactions", height), &summaries); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// SearchResultKind identifies what a Search call matched.
+type SearchResultKind string
+
+const (
+	SearchResultBlock       SearchResultKind = "block"
+	SearchResultTransaction SearchResultKind = "transaction"
+)
+
+// SearchResult is the outcome of searching the explorer index by hash.
+type SearchResult struct {
+	Kind        SearchResultKind    `json:"kind"`
+	Block       *BlockSummary       `json:"block,omitempty"`
+	Transaction *TransactionSummary `json:"transaction,omitempty"`
+}
+
+// Search looks up hash in the explorer index, returning whichever of a
+// block or transaction it matches.
+func (c *Client) Search(ctx context.Context, hash string) (*SearchResult, error) {
+	var result SearchResult
+	if err := c.get(ctx, fmt.Sprintf("/explorer/search/%s", hash), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
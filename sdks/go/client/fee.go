@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// feeBalanceResponse is the shape of the catchup API's fee-state endpoint.
+type feeBalanceResponse struct {
+	Balance string `json:"balance"`
+}
+
+// FetchFeeBalance returns the fee account balance for address, as reported
+// by the node's fee state endpoint.
+func (c *Client) FetchFeeBalance(ctx context.Context, address string) (*types.FeeAmount, error) {
+	var resp feeBalanceResponse
+	path := fmt.Sprintf("/catchup/account/%s", address)
+	if err := c.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	balance, err := types.ParseFeeAmount(resp.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("fetch fee balance: %w", err)
+	}
+	return balance, nil
+}
+
+// EstimateFee returns the estimated cost, in wei, of submitting a payload
+// of payloadSize bytes to namespace, based on the base fee from the latest
+// header and the chain's fee parameters. This is an estimate: the actual
+// fee charged is determined by the sequencer at submission time.
+func (c *Client) EstimateFee(ctx context.Context, namespace types.NamespaceId, payloadSize uint64) (*types.FeeAmount, error) {
+	height, err := c.FetchLatestBlockHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("estimate fee: %w", err)
+	}
+	header, err := c.FetchHeaderByHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("estimate fee: %w", err)
+	}
+
+	cfg, err := c.FetchChainConfig(ctx, header.Height)
+	if err != nil {
+		return nil, fmt.Errorf("estimate fee: %w", err)
+	}
+
+	return cfg.BaseFee.Mul(payloadSize), nil
+}
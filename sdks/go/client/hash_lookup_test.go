@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchHeaderByHash(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/availability/header/hash/abc" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{
+			"height": 7,
+			"timestamp": 100,
+			"l1_head": 1,
+			"payload_commitment": "PAYLOAD~AAA",
+			"builder_commitment": "BUILDER~AAA",
+			"ns_table": "deadbeef",
+			"block_merkle_tree_root": "BLOCK~AAA",
+			"fee_merkle_tree_root": "FEE~AAA"
+		}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	header, err := c.FetchHeaderByHash(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("FetchHeaderByHash: %v", err)
+	}
+	if header.Height != 7 {
+		t.Fatalf("got height %d", header.Height)
+	}
+}
+
+func TestFetchBlockByHash(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/availability/block/hash/abc" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"header":{"height":7},"num_transactions":3}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	block, err := c.FetchBlockByHash(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("FetchBlockByHash: %v", err)
+	}
+	if block.NumTransactions != 3 {
+		t.Fatalf("got %d", block.NumTransactions)
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"time"
+)
+
+// WithHedging enables hedged GET requests: if the primary request hasn't
+// returned within delay, the client additionally issues the same request
+// against each of secondaryURLs and takes whichever response arrives first.
+// The losing requests are canceled.
+//
+// This trades extra load on secondaryURLs for lower tail latency, which
+// matters for confirmation flows that wait on a single slow public query
+// node. It has no effect on requests routed over gRPC.
+func WithHedging(delay time.Duration, secondaryURLs ...string) Option {
+	return func(c *Client) {
+		c.hedgeDelay = delay
+		c.hedgeURLs = secondaryURLs
+	}
+}
+
+type hedgeResult struct {
+	body []byte
+	err  error
+}
+
+// getHedged is the hedging counterpart to get: it races the primary base
+// URL against c.hedgeURLs, started delay after the primary, and decodes
+// whichever response arrives first.
+func (c *Client) getHedged(ctx context.Context, path string, out interface{}) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	urls := append([]string{c.baseURL}, c.hedgeURLs...)
+	results := make(chan hedgeResult, len(urls))
+
+	for i, baseURL := range urls {
+		baseURL := baseURL
+		delay := time.Duration(0)
+		if i > 0 {
+			delay = c.hedgeDelay
+		}
+		go func() {
+			if delay > 0 {
+				timer := time.NewTimer(delay)
+				defer timer.Stop()
+				select {
+				case <-ctx.Done():
+					return
+				case <-timer.C:
+				}
+			}
+			body, err := c.fetchBytesFrom(ctx, baseURL, path)
+			select {
+			case results <- hedgeResult{body: body, err: err}:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	var lastErr error
+	for range urls {
+		select {
+		case res := <-results:
+			if res.err != nil {
+				lastErr = res.err
+				continue
+			}
+			if err := c.codec.Codec("").Decode(bytes.NewReader(res.body), out); err != nil {
+				return newDecodeError(path, res.body, err)
+			}
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return lastErr
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetHedgedTakesFasterSecondary(t *testing.T) {
+	var slowHits int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&slowHits, 1)
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(`1`))
+	}))
+	defer slow.Close()
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`2`))
+	}))
+	defer fast.Close()
+
+	c := NewClient(slow.URL, WithHedging(20*time.Millisecond, fast.URL))
+
+	var height uint64
+	if err := c.get(context.Background(), "/status/block-height", &height); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if height != 2 {
+		t.Fatalf("got height %d, want hedged response 2", height)
+	}
+}
+
+func TestGetHedgedFallsBackOnPrimaryError(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`7`))
+	}))
+	defer good.Close()
+
+	c := NewClient(bad.URL, WithHedging(10*time.Millisecond, good.URL))
+
+	var height uint64
+	if err := c.get(context.Background(), "/status/block-height", &height); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if height != 7 {
+		t.Fatalf("got height %d, want fallback response 7", height)
+	}
+}
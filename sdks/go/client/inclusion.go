@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+	"github.com/socialsister/espresso-sequencer/sdks/go/verification"
+)
+
+// LightClientReader supplies the L1 light client's view of finalized block
+// commitments. The SDK doesn't talk to L1 itself; callers already have a
+// binding for the light client contract (an abigen'd Ethereum client, a
+// Cosmos SDK light client, etc.) and plug it in here so
+// VerifyTransactionInclusion can check that the header it verified against
+// is the one actually finalized on L1, not just a well-formed one a query
+// node happened to serve.
+type LightClientReader interface {
+	// BlockCommitmentForHeight returns the payload commitment the light
+	// client contract has finalized for height. It should return an error
+	// if height hasn't been finalized yet, rather than a zero value.
+	BlockCommitmentForHeight(ctx context.Context, height uint64) (string, error)
+}
+
+// TransactionInclusionAttestation is proof that a transaction was included
+// in a specific, L1-finalized block: the namespace proof and block merkle
+// proof both checked out, and the header they were checked against matches
+// what the light client contract finalized for Height.
+type TransactionInclusionAttestation struct {
+	Hash       TransactionHash
+	Height     uint64
+	Namespace  types.NamespaceId
+	Commitment string
+}
+
+// VerifyTransactionInclusion runs the complete trust path a rollup needs
+// for a submitted transaction: it looks up hash's location, fetches the
+// header, namespace proof, VID common, and block merkle proof for that
+// location, checks both proofs against the header, and checks the header
+// itself against lc's finalized commitment for that height. Previously
+// this required stitching FetchTransactionWithProof, the verification
+// package, and a caller's own light client binding together by hand.
+func VerifyTransactionInclusion(ctx context.Context, c EspressoClient, lc LightClientReader, hash TransactionHash) (*TransactionInclusionAttestation, error) {
+	txProof, err := c.FetchTransactionWithProof(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("fetch transaction with proof: %w", err)
+	}
+
+	headerJSON, err := c.FetchRawHeaderByHeight(ctx, txProof.Height)
+	if err != nil {
+		return nil, fmt.Errorf("fetch header: %w", err)
+	}
+	var header types.HeaderImpl
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("decode header: %w", err)
+	}
+
+	commitment, err := lc.BlockCommitmentForHeight(ctx, txProof.Height)
+	if err != nil {
+		return nil, fmt.Errorf("fetch light client commitment: %w", err)
+	}
+	if commitment != header.PayloadCommitment {
+		return nil, fmt.Errorf("client: header at height %d is not the one finalized on L1 (header commitment %s, light client commitment %s)",
+			txProof.Height, header.PayloadCommitment, commitment)
+	}
+
+	vidCommonJSON, err := c.FetchRawVidCommonByHeight(ctx, txProof.Height)
+	if err != nil {
+		return nil, fmt.Errorf("fetch vid common: %w", err)
+	}
+	proofJSON, err := c.FetchRawNamespaceProof(ctx, txProof.Height, txProof.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("fetch namespace proof: %w", err)
+	}
+	if err := verification.VerifyNamespace(ctx, headerJSON, vidCommonJSON, proofJSON); err != nil {
+		return nil, fmt.Errorf("verify namespace proof: %w", err)
+	}
+
+	merkleProofJSON, err := c.FetchRawBlockMerkleProof(ctx, txProof.Height, txProof.Height)
+	if err != nil {
+		return nil, fmt.Errorf("fetch block merkle proof: %w", err)
+	}
+	var merkleProof types.BlockMerkleProof
+	if err := json.Unmarshal(merkleProofJSON, &merkleProof); err != nil {
+		return nil, fmt.Errorf("decode block merkle proof: %w", err)
+	}
+	// header's own BlockMerkleRoot is already trustworthy here - it was
+	// checked against lc's finalized PayloadCommitment above - so it's the
+	// commitment to verify merkleProof against.
+	if err := verification.VerifyMerkleProof(ctx, header, merkleProof, types.Commitment(header.BlockMerkleRoot)); err != nil {
+		return nil, fmt.Errorf("verify block merkle proof: %w", err)
+	}
+
+	return &TransactionInclusionAttestation{
+		Hash:       hash,
+		Height:     txProof.Height,
+		Namespace:  txProof.Namespace,
+		Commitment: commitment,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+type fakeLightClientReader struct {
+	commitment string
+	err        error
+}
+
+func (f fakeLightClientReader) BlockCommitmentForHeight(ctx context.Context, height uint64) (string, error) {
+	return f.commitment, f.err
+}
+
+func TestVerifyTransactionInclusionRejectsCommitmentMismatch(t *testing.T) {
+	headerJSON, _ := json.Marshal(types.HeaderImpl{Height: 5, PayloadCommitment: "server-says-this"})
+
+	mc := &fakeInclusionClient{
+		txProof:    &TransactionWithProof{Height: 5, Namespace: 1},
+		headerJSON: headerJSON,
+	}
+	lc := fakeLightClientReader{commitment: "l1-finalized-this"}
+
+	_, err := VerifyTransactionInclusion(context.Background(), mc, lc, TransactionHash("tx-hash"))
+	if err == nil || !strings.Contains(err.Error(), "not the one finalized on L1") {
+		t.Fatalf("got %v, want a commitment mismatch error", err)
+	}
+}
+
+// fakeInclusionClient implements the subset of EspressoClient
+// VerifyTransactionInclusion needs, enough to drive it up to the light
+// client check without requiring a cgo-linked verifier.
+type fakeInclusionClient struct {
+	EspressoClient
+	txProof    *TransactionWithProof
+	headerJSON json.RawMessage
+}
+
+func (f *fakeInclusionClient) FetchTransactionWithProof(ctx context.Context, hash TransactionHash) (*TransactionWithProof, error) {
+	return f.txProof, nil
+}
+
+func (f *fakeInclusionClient) FetchRawHeaderByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	return f.headerJSON, nil
+}
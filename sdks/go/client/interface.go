@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// EspressoClient covers the fetch and submit methods of Client. Extracting
+// it lets downstream projects depend on an interface instead of the
+// concrete struct, so their own tests can substitute clientmock.Client
+// instead of requiring a live dev node.
+type EspressoClient interface {
+	FetchLatestBlockHeight(ctx context.Context) (uint64, error)
+	FetchHeaderByHeight(ctx context.Context, height uint64) (*types.HeaderImpl, error)
+	FetchHeaderByHash(ctx context.Context, hash string) (*types.HeaderImpl, error)
+	FetchRawHeaderByHeight(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawHeaderByHash(ctx context.Context, hash string) (json.RawMessage, error)
+	FetchHeadersByRange(ctx context.Context, from, to uint64) ([]*types.HeaderImpl, error)
+	FetchHeadersByRangeStream(ctx context.Context, from, to uint64) (<-chan []*types.HeaderImpl, <-chan error)
+	Headers(ctx context.Context, from, to uint64) iter.Seq[*types.HeaderImpl]
+
+	FetchBlockByHeight(ctx context.Context, height uint64) (*Block, error)
+	FetchBlockByHash(ctx context.Context, hash string) (*Block, error)
+	FetchRawBlockByHeight(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawBlockByHash(ctx context.Context, hash string) (json.RawMessage, error)
+	FetchBlockBundle(ctx context.Context, height uint64) (*BlockBundle, error)
+	FetchLeafByHeight(ctx context.Context, height uint64) (*types.Leaf, error)
+	FetchLeafRange(ctx context.Context, from, to uint64) ([]*types.Leaf, error)
+	FetchRawLeafByHeight(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawNamespaceProof(ctx context.Context, height uint64, namespace types.NamespaceId) (json.RawMessage, error)
+	FetchRawVidCommonByHeight(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawBlockMerkleProof(ctx context.Context, height, targetHeight uint64) (json.RawMessage, error)
+	FetchBlockSummaries(ctx context.Context, from, to uint64) ([]BlockSummary, error)
+	FetchTransactionSummaries(ctx context.Context, height uint64) ([]TransactionSummary, error)
+	Search(ctx context.Context, hash string) (*SearchResult, error)
+
+	FetchNodeStatus(ctx context.Context) (*NodeStatus, error)
+	Ping(ctx context.Context) error
+	FetchServerVersion(ctx context.Context) (string, error)
+
+	FetchFeeBalance(ctx context.Context, address string) (*types.FeeAmount, error)
+	EstimateFee(ctx context.Context, namespace types.NamespaceId, payloadSize uint64) (*types.FeeAmount, error)
+	FetchChainConfig(ctx context.Context, height uint64) (*types.ChainConfig, error)
+	ResolveChainConfig(ctx context.Context, height uint64, rcc types.ResolvableChainConfig) (*types.ChainConfig, error)
+
+	FetchStakeTable(ctx context.Context) ([]types.StakeTableEntry, error)
+	FetchStakeTableAtEpoch(ctx context.Context, epoch uint64) ([]types.StakeTableEntry, error)
+
+	FetchCurrentEpoch(ctx context.Context) (uint64, error)
+	FetchEpochInfo(ctx context.Context, epoch uint64) (*types.EpochInfo, error)
+	FetchRewardAccount(ctx context.Context, address string) (*types.RewardAccountState, error)
+
+	FetchTransactionWithProof(ctx context.Context, hash TransactionHash) (*TransactionWithProof, error)
+	FetchAndVerifyTransactionsInNamespace(ctx context.Context, height uint64, namespace types.NamespaceId) ([]types.Transaction, error)
+
+	AwaitBlockHeight(ctx context.Context, height uint64) error
+
+	SubscribeHeaders(ctx context.Context, fromHeight uint64) <-chan *types.HeaderImpl
+	SubscribeTransactionsByNamespace(ctx context.Context, namespace types.NamespaceId, fromHeight uint64) <-chan NamespaceTransactions
+
+	SubmitTransaction(ctx context.Context, tx types.Transaction) (TransactionHash, error)
+	SubmitTransactions(ctx context.Context, txs []types.Transaction) ([]BatchSubmitResult, error)
+	SubmitTransactionAndWait(ctx context.Context, tx types.Transaction, opts SubmitAndWaitOptions) (*TransactionReceipt, error)
+}
+
+var _ EspressoClient = (*Client)(nil)
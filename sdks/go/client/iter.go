@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// Headers returns an iterator over the headers in [from, to) held in the
+// store; it never fetches from the network.
+// Heights not present in the store are simply skipped; use Client.Headers
+// to fetch the full, contiguous range from the network instead.
+func (s *Store) Headers(from, to uint64) iter.Seq[*types.HeaderImpl] {
+	return func(yield func(*types.HeaderImpl) bool) {
+		for h := from; h < to; h++ {
+			header, ok := s.Get(h)
+			if !ok {
+				continue
+			}
+			if !yield(header) {
+				return
+			}
+		}
+	}
+}
+
+// Headers returns an iterator that fetches headers for [from, to) from the
+// query service one at a time, stopping early if the consumer breaks out of
+// the range-over-func loop or a fetch fails. Callers that need the error
+// from a failed fetch should use FetchHeaderByHeight directly; this
+// iterator is for the common case of walking a known-good range.
+func (c *Client) Headers(ctx context.Context, from, to uint64) iter.Seq[*types.HeaderImpl] {
+	return func(yield func(*types.HeaderImpl) bool) {
+		for h := from; h < to; h++ {
+			header, err := c.FetchHeaderByHeight(ctx, h)
+			if err != nil {
+				return
+			}
+			if !yield(header) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FetchLeafByHeight returns the HotShot leaf at the given height, including
+// the quorum certificate that finalized it.
+func (c *Client) FetchLeafByHeight(ctx context.Context, height uint64) (*types.Leaf, error) {
+	var leaf types.Leaf
+	if err := c.get(ctx, fmt.Sprintf("/availability/leaf/%d", height), &leaf); err != nil {
+		return nil, err
+	}
+	return &leaf, nil
+}
+
+// FetchLeafRange returns leaves for [from, to), chunked the same way
+// FetchHeadersByRange is to stay under the server's per-request limit.
+func (c *Client) FetchLeafRange(ctx context.Context, from, to uint64) ([]*types.Leaf, error) {
+	var result []*types.Leaf
+	for chunk := range chunkRange(from, to, maxHeadersPerRequest) {
+		var leaves []*types.Leaf
+		path := fmt.Sprintf("/availability/leaf/%d/%d", chunk.from, chunk.to)
+		if err := c.get(ctx, path, &leaves); err != nil {
+			return nil, err
+		}
+		result = append(result, leaves...)
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchLeafByHeight(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/availability/leaf/5" {
+			t.Fatalf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`{"height":5,"view_number":7,"quorum_certificate":{"view_number":7}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	leaf, err := c.FetchLeafByHeight(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("FetchLeafByHeight: %v", err)
+	}
+	if leaf.Height != 5 || leaf.QC.View != 7 {
+		t.Fatalf("got %+v", leaf)
+	}
+}
+
+func TestFetchLeafRange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/availability/leaf/1/3" {
+			t.Fatalf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`[{"height":1},{"height":2}]`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	leaves, err := c.FetchLeafRange(context.Background(), 1, 3)
+	if err != nil {
+		t.Fatalf("FetchLeafRange: %v", err)
+	}
+	if len(leaves) != 2 {
+		t.Fatalf("got %d leaves", len(leaves))
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// ErrResponseTooLarge is returned when a response body exceeds the client's
+// configured MaxResponseBytes, instead of the client silently truncating or
+// buffering an unbounded amount of data.
+var ErrResponseTooLarge = errors.New("espresso client: response exceeds configured size limit")
+
+// WithMaxResponseSize caps the number of bytes the client will read from any
+// single GET response body; responses to SubmitTransaction and
+// SubmitTransactions are not capped. Block payloads and header ranges can be large, and
+// without a cap a misbehaving or malicious query node can exhaust memory on
+// the caller. Zero (the default) leaves responses unbounded.
+func WithMaxResponseSize(n int64) Option {
+	return func(c *Client) { c.maxResponseBytes = n }
+}
+
+// limitBody wraps r so reads past c.maxResponseBytes fail with
+// ErrResponseTooLarge rather than continuing unbounded. A no-op when no
+// limit is configured.
+func (c *Client) limitBody(r io.Reader) io.Reader {
+	if c.maxResponseBytes <= 0 {
+		return r
+	}
+	return &limitReader{r: r, remaining: c.maxResponseBytes}
+}
+
+type limitReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (lr *limitReader) Read(p []byte) (int, error) {
+	if lr.remaining <= 0 {
+		// A body of exactly the limit is allowed; only a further byte fails.
+		var probe [1]byte
+		if n, err := lr.r.Read(probe[:]); n == 0 && err == io.EOF {
+			return 0, io.EOF
+		}
+		return 0, ErrResponseTooLarge
+	}
+	if int64(len(p)) > lr.remaining {
+		p = p[:lr.remaining]
+	}
+	n, err := lr.r.Read(p)
+	lr.remaining -= int64(n)
+	return n, err
+}
+
+// countingReader tracks the number of bytes read through it, so callers that
+// stream a response straight into a decoder can still report accurate sizes
+// to metrics without buffering the body first. It also retains a bounded
+// snippet of the leading bytes, so a decode failure can still be reported
+// with context even though the full body was never buffered.
+type countingReader struct {
+	r       io.Reader
+	n       int
+	snippet bytes.Buffer
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += n
+	if n > 0 && cr.snippet.Len() < maxErrorBodySnippet {
+		remaining := maxErrorBodySnippet - cr.snippet.Len()
+		if remaining > n {
+			remaining = n
+		}
+		cr.snippet.Write(p[:remaining])
+	}
+	return n, err
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLimitReaderErrorsPastLimit(t *testing.T) {
+	c := &Client{maxResponseBytes: 4}
+	r := c.limitBody(strings.NewReader("hello world"))
+
+	buf := make([]byte, 64)
+	n, err := r.Read(buf)
+	if n != 4 {
+		t.Fatalf("expected to read exactly 4 bytes before the limit, got %d", n)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error on first read: %v", err)
+	}
+
+	_, err = r.Read(buf)
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+}
+
+func TestLimitBodyNoopWhenUnset(t *testing.T) {
+	c := &Client{}
+	r := c.limitBody(strings.NewReader("hello"))
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != "hello" {
+		t.Fatalf("got %q, want %q", got, "hello")
+	}
+}
+
+func TestCountingReader(t *testing.T) {
+	cr := &countingReader{r: strings.NewReader("hello world")}
+	if _, err := io.ReadAll(cr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cr.n != len("hello world") {
+		t.Fatalf("got %d bytes counted, want %d", cr.n, len("hello world"))
+	}
+}
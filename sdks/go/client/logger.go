@@ -0,0 +1,40 @@
+package client
+
+// Logger is implemented by structured loggers the client can use for
+// retries, reconnects, and slow requests. It matches the common
+// key-value-pairs shape (zap's SugaredLogger, zerolog, slog) closely enough
+// that an adapter is typically a few lines.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// nopLogger discards everything. It is the client's default so call sites
+// never need a nil check.
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+// WithLogger configures the Logger used for diagnostic output. The default
+// is a no-op logger, matching the client's current silent behavior.
+func WithLogger(l Logger) Option {
+	return func(c *Client) { c.logger = l }
+}
+
+// log returns c.logger, falling back to nopLogger if it's nil - e.g.
+// because c was built as a bare &Client{} rather than through NewClient, as
+// plenty of this package's own tests do. Every logging call site should go
+// through this rather than reading c.logger directly, so nopLogger's "call
+// sites never need a nil check" guarantee actually holds regardless of how
+// c was constructed.
+func (c *Client) log() Logger {
+	if c.logger == nil {
+		return nopLogger{}
+	}
+	return c.logger
+}
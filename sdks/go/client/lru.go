@@ -0,0 +1,71 @@
+package client
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache is a fixed-capacity, least-recently-used cache. It's used to
+// memoize responses that are immutable once finalized (headers, VID common,
+// merkle proofs), keyed by request path.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+func (c *lruCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) put(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&lruEntry{key: key, value: value})
+	c.items[key] = el
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest == nil {
+			break
+		}
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// WithQueryCache enables an in-memory LRU cache of up to size responses
+// from immutable endpoints (headers, VID common, merkle proofs by
+// height/hash). It does not cache endpoints whose result can change, such
+// as the latest block height.
+func WithQueryCache(size int) Option {
+	return func(c *Client) { c.cache = newLRUCache(size) }
+}
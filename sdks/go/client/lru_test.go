@@ -0,0 +1,32 @@
+package client
+
+import "testing"
+
+func TestLRUCacheEvictsOldest(t *testing.T) {
+	c := newLRUCache(2)
+	c.put("a", []byte("1"))
+	c.put("b", []byte("2"))
+	c.put("c", []byte("3"))
+
+	if _, ok := c.get("a"); ok {
+		t.Fatal("expected a to be evicted")
+	}
+	if v, ok := c.get("c"); !ok || string(v) != "3" {
+		t.Fatal("expected c to be cached")
+	}
+}
+
+func TestLRUCacheTouchOnGet(t *testing.T) {
+	c := newLRUCache(2)
+	c.put("a", []byte("1"))
+	c.put("b", []byte("2"))
+	c.get("a") // touch a, making b the least recently used
+	c.put("c", []byte("3"))
+
+	if _, ok := c.get("b"); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("expected a to survive due to recent access")
+	}
+}
@@ -0,0 +1,28 @@
+package client
+
+import "time"
+
+// MetricsRecorder receives measurements for every outgoing request. It
+// mirrors the subset of prometheus.Registerer-backed instrumentation the
+// SDK needs (counters and histograms) without taking a hard dependency on
+// the prometheus client library, since not every consumer uses Prometheus.
+type MetricsRecorder interface {
+	// ObserveRequest is called once per request with the endpoint path,
+	// the resulting HTTP status (0 if the request never got a response),
+	// the request duration, and the decoded response size in bytes.
+	ObserveRequest(endpoint string, statusCode int, duration time.Duration, responseBytes int)
+}
+
+// WithMetrics registers a MetricsRecorder that observes every request the
+// client makes. Use this to wire in a Prometheus-backed implementation
+// without the SDK depending on the prometheus client library directly.
+func WithMetrics(m MetricsRecorder) Option {
+	return func(c *Client) { c.metrics = m }
+}
+
+func (c *Client) recordMetrics(endpoint string, statusCode int, start time.Time, responseBytes int) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.ObserveRequest(endpoint, statusCode, time.Since(start), responseBytes)
+}
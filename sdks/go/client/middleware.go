@@ -0,0 +1,40 @@
+package client
+
+import "net/http"
+
+// RoundTripperFunc adapts a function to an http.RoundTripper, mirroring the
+// standard library's http.HandlerFunc pattern.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps an http.RoundTripper to add cross-cutting behavior, such
+// as logging, extra headers, or metrics, around every outgoing request.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// WithMiddleware chains mw around the client's transport, in the order
+// given: the first Middleware is outermost, seeing the request first and
+// the response last. Use this for behavior that doesn't fit the client's
+// existing typed options (WithMetrics, WithTracing, WithLogger), such as
+// request logging middleware shared with other HTTP clients in the same
+// service.
+//
+// WithMiddleware wraps whatever transport is already configured, so pass it
+// after WithTLSConfig, WithProxy, or WithTransportTuning in NewClient's
+// option list; those options require the transport still be a concrete
+// *http.Transport, which a middleware-wrapped transport no longer is.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(c *Client) {
+		transport := c.httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		for i := len(mw) - 1; i >= 0; i-- {
+			transport = mw[i](transport)
+		}
+		c.httpClient.Transport = transport
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithMiddlewareSeesRequestsAndResponses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`7`))
+	}))
+	defer srv.Close()
+
+	var sawHeader string
+	mw := Middleware(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Mw", "yes")
+			resp, err := next.RoundTrip(req)
+			if resp != nil {
+				sawHeader = req.Header.Get("X-Mw")
+			}
+			return resp, err
+		})
+	})
+
+	c := NewClient(srv.URL, WithMiddleware(mw))
+
+	var height uint64
+	if err := c.get(context.Background(), "/status/block-height", &height); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if height != 7 {
+		t.Fatalf("got %d", height)
+	}
+	if sawHeader != "yes" {
+		t.Fatalf("middleware did not run")
+	}
+}
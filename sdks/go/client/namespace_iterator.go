@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// NamespaceCursor identifies a position within a namespace's transaction
+// stream: a block height and the index of the next transaction within that
+// block's namespace proof. It is safe to persist and later pass back to
+// NewNamespaceIterator to resume derivation after a restart.
+type NamespaceCursor struct {
+	Height  uint64
+	TxIndex int
+}
+
+// NamespaceIterator walks the transactions belonging to a single namespace,
+// starting from a cursor, blocking on AwaitBlockHeight as it catches up to
+// the chain tip. Unlike SubscribeTransactionsByNamespace, it is pull-based
+// and exposes its cursor, so a rollup node can persist it and resume
+// derivation from exactly where it left off instead of re-scanning.
+type NamespaceIterator struct {
+	client    *Client
+	namespace types.NamespaceId
+	cursor    NamespaceCursor
+	txs       []types.Transaction
+	// fetched reports whether txs holds the result of fetching
+	// cursor.Height's namespace proof already. It's false for a freshly
+	// constructed iterator (cursor.Height itself hasn't been fetched yet)
+	// and after Next advances past an exhausted block, so Next knows
+	// whether reaching the end of txs means "fetch this height" or
+	// "move on to the next height first".
+	fetched bool
+}
+
+// NewNamespaceIterator returns an iterator over namespace's transactions
+// starting at from. Pass the zero NamespaceCursor to start from genesis, or
+// a previously saved Cursor() to resume.
+func (c *Client) NewNamespaceIterator(namespace types.NamespaceId, from NamespaceCursor) *NamespaceIterator {
+	return &NamespaceIterator{client: c, namespace: namespace, cursor: from}
+}
+
+// Cursor returns the iterator's current position, suitable for persisting
+// and later passing to NewNamespaceIterator.
+func (it *NamespaceIterator) Cursor() NamespaceCursor {
+	return it.cursor
+}
+
+// Next blocks until the next transaction in the namespace is available,
+// advancing the cursor past it. It waits for new blocks at the chain tip
+// rather than returning an error.
+func (it *NamespaceIterator) Next(ctx context.Context) (types.Transaction, error) {
+	for it.cursor.TxIndex >= len(it.txs) {
+		if it.fetched {
+			// cursor.Height's block is exhausted, whether that took one
+			// call to Next or many - advance to the next height and fetch
+			// that one instead of re-fetching the same block forever.
+			it.cursor = NamespaceCursor{Height: it.cursor.Height + 1, TxIndex: 0}
+			it.fetched = false
+		}
+		if err := it.client.AwaitBlockHeight(ctx, it.cursor.Height+1); err != nil {
+			return types.Transaction{}, err
+		}
+		proof, err := it.client.fetchNamespaceProof(ctx, it.cursor.Height, it.namespace)
+		if err != nil {
+			return types.Transaction{}, err
+		}
+		it.txs = proof.Transactions
+		it.fetched = true
+	}
+
+	tx := it.txs[it.cursor.TxIndex]
+	it.cursor.TxIndex++
+	return tx, nil
+}
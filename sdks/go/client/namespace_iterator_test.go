@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNamespaceIteratorWalksTransactionsAndAdvancesCursor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/status/block-height":
+			json.NewEncoder(w).Encode(5)
+		case strings.HasPrefix(r.URL.Path, "/availability/block/0/namespace/"):
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"transactions": []map[string]interface{}{
+					{"namespace": 7, "payload": "AQ=="},
+					{"namespace": 7, "payload": "Ag=="},
+				},
+			})
+		case strings.HasPrefix(r.URL.Path, "/availability/block/1/namespace/"):
+			json.NewEncoder(w).Encode(map[string]interface{}{"transactions": []map[string]interface{}{}})
+		case strings.HasPrefix(r.URL.Path, "/availability/block/2/namespace/"):
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"transactions": []map[string]interface{}{
+					{"namespace": 7, "payload": "Aw=="},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	it := c.NewNamespaceIterator(7, NamespaceCursor{})
+
+	var payloads []byte
+	for i := 0; i < 3; i++ {
+		tx, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		payloads = append(payloads, tx.Payload...)
+	}
+
+	if string(payloads) != "\x01\x02\x03" {
+		t.Fatalf("got payloads %v", payloads)
+	}
+	if got := it.Cursor(); got != (NamespaceCursor{Height: 2, TxIndex: 1}) {
+		t.Fatalf("got cursor %+v", got)
+	}
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// NamespaceTransactions is a single delivery from
+// SubscribeTransactionsByNamespace: the transactions belonging to namespace
+// at Height, together with the proof that they are the complete set.
+type NamespaceTransactions struct {
+	Height uint64
+	Proof  *types.NamespaceProof
+}
+
+// SubscribeTransactionsByNamespace streams the transactions belonging to
+// namespace as new blocks land, starting at fromHeight. It is built on the
+// same reconnect/backoff machinery as SubscribeHeaders, so rollup
+// integrators don't need to hand-roll the polling loop themselves.
+//
+// The returned channel is closed when ctx is cancelled.
+func (c *Client) SubscribeTransactionsByNamespace(ctx context.Context, namespace types.NamespaceId, fromHeight uint64) <-chan NamespaceTransactions {
+	out := make(chan NamespaceTransactions)
+	headers := c.SubscribeHeaders(ctx, fromHeight)
+
+	go func() {
+		defer close(out)
+		for header := range headers {
+			proof, err := c.fetchNamespaceProof(ctx, header.Height, namespace)
+			if err != nil {
+				// The header still arrived, so the chain is progressing;
+				// skip this height rather than stalling the whole
+				// subscription on a single bad fetch.
+				continue
+			}
+			select {
+			case out <- NamespaceTransactions{Height: header.Height, Proof: proof}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func (c *Client) fetchNamespaceProof(ctx context.Context, height uint64, namespace types.NamespaceId) (*types.NamespaceProof, error) {
+	var proof types.NamespaceProof
+	path := fmt.Sprintf("/availability/block/%d/namespace/%d", height, namespace)
+	if err := c.get(ctx, path, &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client constructed with NewClient. Options are
+// applied in order, so later options can override earlier ones.
+type Option func(*Client)
+
+// WithHTTPClient overrides the underlying http.Client, e.g. to share a
+// connection pool across multiple Espresso clients.
+func WithHTTPClient(h *http.Client) Option {
+	return func(c *Client) { c.httpClient = h }
+}
+
+// WithTimeout sets a per-request timeout on the client's transport. It is
+// equivalent to setting http.Client.Timeout directly, but composes with the
+// other Option helpers.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) { c.httpClient.Timeout = d }
+}
+
+// WithDefaultHeaders sets headers to include on every outgoing request,
+// e.g. for authentication or routing through a gateway.
+func WithDefaultHeaders(headers http.Header) Option {
+	return func(c *Client) { c.defaultHeaders = headers.Clone() }
+}
+
+// WithTLSConfig overrides the TLS configuration used for HTTPS requests.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) {
+		transport := ensureHTTPTransport(c)
+		transport.TLSClientConfig = cfg
+	}
+}
+
+// WithProxy routes requests through proxyURL instead of the environment's
+// default proxy settings.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(c *Client) {
+		transport := ensureHTTPTransport(c)
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+}
+
+// WithRetryPolicy sets the RetryPolicy applied to idempotent fetch methods.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) { c.retryPolicy = p }
+}
+
+// WithStrictDecoding rejects response fields the SDK's types don't
+// recognize instead of silently ignoring them. The default is lenient, so
+// the SDK keeps working against query services that have added fields the
+// client doesn't know about yet.
+func WithStrictDecoding() Option {
+	return func(c *Client) { c.codec.SetStrict(true) }
+}
+
+// TransportTuning configures the underlying HTTP/1.1 and HTTP/2 connection
+// behavior. The zero value for any field leaves Go's http.Transport default
+// in place.
+type TransportTuning struct {
+	// MaxIdleConns caps idle connections across all hosts.
+	MaxIdleConns int
+	// MaxConnsPerHost caps total connections (idle and active) to a single
+	// host, including HTTP/2 streams multiplexed over one connection.
+	MaxConnsPerHost int
+	// MaxIdleConnsPerHost caps idle connections kept open per host.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout closes idle connections after this long.
+	IdleConnTimeout time.Duration
+	// DisableHTTP2 forces HTTP/1.1 even when the server supports h2c/TLS
+	// ALPN negotiation, for query nodes behind proxies that mishandle HTTP/2.
+	DisableHTTP2 bool
+}
+
+// WithTransportTuning applies connection pooling and HTTP/2 settings to the
+// client's transport, for heavy derivation workloads that exhaust ephemeral
+// ports or serialize on the default transport's limits.
+func WithTransportTuning(t TransportTuning) Option {
+	return func(c *Client) {
+		transport := ensureHTTPTransport(c)
+		if t.MaxIdleConns > 0 {
+			transport.MaxIdleConns = t.MaxIdleConns
+		}
+		if t.MaxConnsPerHost > 0 {
+			transport.MaxConnsPerHost = t.MaxConnsPerHost
+		}
+		if t.MaxIdleConnsPerHost > 0 {
+			transport.MaxIdleConnsPerHost = t.MaxIdleConnsPerHost
+		}
+		if t.IdleConnTimeout > 0 {
+			transport.IdleConnTimeout = t.IdleConnTimeout
+		}
+		if t.DisableHTTP2 {
+			transport.ForceAttemptHTTP2 = false
+			transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+	}
+}
+
+// ensureHTTPTransport returns c.httpClient.Transport as an *http.Transport,
+// creating one if the client doesn't already have one (e.g. it is still
+// using http.DefaultTransport).
+func ensureHTTPTransport(c *Client) *http.Transport {
+	t, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok || t == nil {
+		t = http.DefaultTransport.(*http.Transport).Clone()
+		c.httpClient.Transport = t
+	}
+	return t
+}
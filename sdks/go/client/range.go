@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// maxHeadersPerRequest mirrors the query service's own limit on how many
+// headers a single /availability/headers request will return.
+const maxHeadersPerRequest = 500
+
+// FetchHeadersByRange returns headers for [from, to). Ranges larger than
+// the server's own per-request limit are transparently split into chunks
+// and reassembled, so callers don't need to know that limit exists.
+func (c *Client) FetchHeadersByRange(ctx context.Context, from, to uint64) ([]*types.HeaderImpl, error) {
+	var result []*types.HeaderImpl
+	for chunk := range chunkRange(from, to, maxHeadersPerRequest) {
+		headers, err := c.fetchHeaderChunk(ctx, chunk.from, chunk.to)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, headers...)
+	}
+	return result, nil
+}
+
+// FetchHeadersByRangeStream is like FetchHeadersByRange but yields each
+// chunk's headers as soon as it arrives, instead of waiting for the whole
+// range.
+func (c *Client) FetchHeadersByRangeStream(ctx context.Context, from, to uint64) (<-chan []*types.HeaderImpl, <-chan error) {
+	out := make(chan []*types.HeaderImpl)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(out)
+		defer close(errc)
+		for chunk := range chunkRange(from, to, maxHeadersPerRequest) {
+			headers, err := c.fetchHeaderChunk(ctx, chunk.from, chunk.to)
+			if err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case out <- headers:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return out, errc
+}
+
+func (c *Client) fetchHeaderChunk(ctx context.Context, from, to uint64) ([]*types.HeaderImpl, error) {
+	var headers []*types.HeaderImpl
+	path := fmt.Sprintf("/availability/headers/%d/%d", from, to)
+	if err := c.get(ctx, path, &headers); err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+type heightRange struct {
+	from, to uint64
+}
+
+// chunkRange splits [from, to) into consecutive ranges of at most size each.
+func chunkRange(from, to uint64, size uint64) func(func(heightRange) bool) {
+	return func(yield func(heightRange) bool) {
+		for start := from; start < to; start += size {
+			end := start + size
+			if end > to {
+				end = to
+			}
+			if !yield(heightRange{from: start, to: end}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package client
+
+import "testing"
+
+func TestChunkRange(t *testing.T) {
+	var got []heightRange
+	for r := range chunkRange(0, 1205, 500) {
+		got = append(got, r)
+	}
+
+	want := []heightRange{{0, 500}, {500, 1000}, {1000, 1205}}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d chunks, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("chunk %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestChunkRangeEmpty(t *testing.T) {
+	count := 0
+	for range chunkRange(5, 5, 500) {
+		count++
+	}
+	if count != 0 {
+		t.Fatalf("expected no chunks for an empty range, got %d", count)
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a minimal token-bucket rate limiter. It exists so the SDK
+// doesn't need to pull in golang.org/x/time/rate for a single use site.
+type tokenBucket struct {
+	mu         sync.Mutex
+	rps        float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rps:        rps,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		elapsed := now.Sub(b.lastRefill).Seconds()
+		b.lastRefill = now
+		b.tokens += elapsed * b.rps
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		deficit := 1 - b.tokens
+		wait := time.Duration(deficit/b.rps*1000) * time.Millisecond
+		b.mu.Unlock()
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// WithRateLimit caps outgoing requests to rps requests per second, allowing
+// short bursts up to burst requests. This keeps historical backfills that
+// hammer FetchHeadersByRange from getting the client banned by public query
+// nodes.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		c.rateLimiter = newTokenBucket(rps, burst)
+	}
+}
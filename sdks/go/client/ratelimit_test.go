@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketAllowsBurst(t *testing.T) {
+	b := newTokenBucket(1, 3)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		if err := b.wait(ctx); err != nil {
+			t.Fatalf("wait: %v", err)
+		}
+		if time.Since(start) > 10*time.Millisecond {
+			t.Fatalf("expected burst token %d to be immediate", i)
+		}
+	}
+}
+
+func TestTokenBucketThrottlesBeyondBurst(t *testing.T) {
+	b := newTokenBucket(100, 1)
+	ctx := context.Background()
+	if err := b.wait(ctx); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	start := time.Now()
+	if err := b.wait(ctx); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if time.Since(start) < 5*time.Millisecond {
+		t.Fatal("expected second request to be throttled")
+	}
+}
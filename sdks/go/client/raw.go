@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FetchRawHeaderByHeight returns the exact bytes the query service sent for
+// the header at height, without decoding them into types.HeaderImpl first.
+// Verification via the FFI needs byte-exact inputs; re-serializing a typed
+// struct can produce a different commitment than the original bytes.
+func (c *Client) FetchRawHeaderByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/header/%d", height), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawHeaderByHash is FetchRawHeaderByHeight looked up by payload
+// commitment hash instead of height.
+func (c *Client) FetchRawHeaderByHash(ctx context.Context, hash string) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/header/hash/%s", hash), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawBlockByHeight is FetchBlockByHeight without decoding the response,
+// for callers that need the exact bytes of the payload and VID common the
+// server sent rather than a re-serialized struct.
+func (c *Client) FetchRawBlockByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/block/%d", height), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawBlockByHash is FetchBlockByHash without decoding the response.
+func (c *Client) FetchRawBlockByHash(ctx context.Context, hash string) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/block/hash/%s", hash), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawLeafByHeight is FetchLeafByHeight without decoding the response.
+func (c *Client) FetchRawLeafByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/leaf/%d", height), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawNamespaceProof returns the exact bytes of the namespace proof for
+// namespace within the block at height, without decoding them first.
+// verification.VerifyNamespace needs this byte-exact, the same way it
+// needs the header's raw bytes.
+func (c *Client) FetchRawNamespaceProof(ctx context.Context, height uint64, namespace types.NamespaceId) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/block/%d/namespace/%d", height, namespace), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawVidCommonByHeight returns the exact bytes of the VID common data
+// for the block at height, without base64-decoding them into a []byte
+// first. verification.VerifyNamespace needs this byte-exact, the same way
+// it needs the header's raw bytes.
+func (c *Client) FetchRawVidCommonByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/vid/common/%d", height), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// FetchRawBlockMerkleProof returns the exact bytes of a block merkle proof
+// that the block at height is included in the block merkle tree as of
+// targetHeight's header, without decoding them into types.BlockMerkleProof
+// first. Callers passing this into verification.VerifyMerkleProof should
+// decode it themselves; the raw form exists for callers that want the
+// server's exact bytes for logging or storage.
+func (c *Client) FetchRawBlockMerkleProof(ctx context.Context, height, targetHeight uint64) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/block-state/%d/%d", targetHeight, height), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchRawHeaderByHeightReturnsExactBytes(t *testing.T) {
+	const body = `{"height":9,"unknown_future_field":"x"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawHeaderByHeight(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("FetchRawHeaderByHeight: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+}
+
+func TestFetchRawHeaderByHashReturnsExactBytes(t *testing.T) {
+	const body = `{"height":9,"unknown_future_field":"x"}`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawHeaderByHash(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("FetchRawHeaderByHash: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/header/hash/abc"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
+
+func TestFetchRawBlockByHeightReturnsExactBytes(t *testing.T) {
+	const body = `{"header":{"height":9},"unknown_future_field":"x"}`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawBlockByHeight(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("FetchRawBlockByHeight: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/block/9"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
+
+func TestFetchRawBlockByHashReturnsExactBytes(t *testing.T) {
+	const body = `{"header":{"height":9},"unknown_future_field":"x"}`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawBlockByHash(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("FetchRawBlockByHash: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/block/hash/abc"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
+
+func TestFetchRawLeafByHeightReturnsExactBytes(t *testing.T) {
+	const body = `{"leaf":{"height":9},"unknown_future_field":"x"}`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawLeafByHeight(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("FetchRawLeafByHeight: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/leaf/9"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
+
+func TestFetchRawNamespaceProofReturnsExactBytes(t *testing.T) {
+	const body = `{"proof":"AQ==","unknown_future_field":"x"}`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawNamespaceProof(context.Background(), 9, 1)
+	if err != nil {
+		t.Fatalf("FetchRawNamespaceProof: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/block/9/namespace/1"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
+
+func TestFetchRawVidCommonByHeightReturnsExactBytes(t *testing.T) {
+	const body = `"AQID"`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawVidCommonByHeight(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("FetchRawVidCommonByHeight: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/vid/common/9"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
+
+func TestFetchRawBlockMerkleProofReturnsExactBytes(t *testing.T) {
+	const body = `{"proof":["a","b"],"path":[1,0]}`
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	raw, err := c.FetchRawBlockMerkleProof(context.Background(), 5, 9)
+	if err != nil {
+		t.Fatalf("FetchRawBlockMerkleProof: %v", err)
+	}
+	if string(raw) != body {
+		t.Fatalf("got %q, want %q", raw, body)
+	}
+	if want := "/availability/block-state/9/5"; gotPath != want {
+		t.Fatalf("got path %q, want %q", gotPath, want)
+	}
+}
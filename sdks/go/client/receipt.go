@@ -0,0 +1,51 @@
+package client
+
+import "fmt"
+
+// ConfirmationLevel describes how durably a submitted transaction has been
+// confirmed, from having merely been accepted by a node's mempool through
+// to being reflected in a finalized L1 commitment.
+type ConfirmationLevel int
+
+const (
+	// ConfirmationSubmitted means the sequencer accepted the transaction
+	// but it has not yet been observed in a block.
+	ConfirmationSubmitted ConfirmationLevel = iota
+	// ConfirmationIncluded means the transaction appears in a sequenced
+	// block, but that block's HotShot finality has not been confirmed.
+	ConfirmationIncluded
+	// ConfirmationHotShotFinalized means the block containing the
+	// transaction has reached HotShot consensus finality. Because HotShot
+	// provides instant finality, a transaction visible through the
+	// availability API has already reached this level.
+	ConfirmationHotShotFinalized
+	// ConfirmationL1Finalized means the block's commitment has been
+	// posted to and finalized on the L1.
+	ConfirmationL1Finalized
+)
+
+func (l ConfirmationLevel) String() string {
+	switch l {
+	case ConfirmationSubmitted:
+		return "submitted"
+	case ConfirmationIncluded:
+		return "included"
+	case ConfirmationHotShotFinalized:
+		return "hotshot_finalized"
+	case ConfirmationL1Finalized:
+		return "l1_finalized"
+	default:
+		return fmt.Sprintf("ConfirmationLevel(%d)", int(l))
+	}
+}
+
+// TransactionReceipt is the outcome of a submitted transaction once it has
+// reached at least its Confirmation level, replacing the ad-hoc structs
+// callers otherwise invent to carry this information around.
+type TransactionReceipt struct {
+	Hash              TransactionHash
+	Height            uint64
+	Index             uint64
+	NamespacePosition uint64
+	Confirmation      ConfirmationLevel
+}
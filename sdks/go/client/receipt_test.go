@@ -0,0 +1,17 @@
+package client
+
+import "testing"
+
+func TestConfirmationLevelString(t *testing.T) {
+	cases := map[ConfirmationLevel]string{
+		ConfirmationSubmitted:        "submitted",
+		ConfirmationIncluded:         "included",
+		ConfirmationHotShotFinalized: "hotshot_finalized",
+		ConfirmationL1Finalized:      "l1_finalized",
+	}
+	for level, want := range cases {
+		if got := level.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", level, got, want)
+		}
+	}
+}
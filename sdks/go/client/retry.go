@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy controls how idempotent fetch methods retry transient
+// failures. The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff.
+	MaxDelay time.Duration
+	// Jitter adds up to this fraction of the computed delay, randomized,
+	// to avoid synchronized retries across many clients.
+	Jitter float64
+	// RetryableStatusCodes overrides which HTTP statuses are retried. If
+	// nil, APIError.Retryable is used.
+	RetryableStatusCodes map[int]bool
+}
+
+// defaultRetryPolicy disables retries, matching the client's behavior
+// before this option existed.
+var defaultRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+func (p RetryPolicy) shouldRetry(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if p.RetryableStatusCodes != nil {
+		return p.RetryableStatusCodes[apiErr.StatusCode]
+	}
+	return apiErr.Retryable()
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 100 * time.Millisecond
+	}
+	d := base * time.Duration(1<<uint(attempt))
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d += time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+	return d
+}
+
+// retryDelay picks how long to wait before the next attempt: the server's
+// Retry-After hint if one came back with the error, otherwise the policy's
+// own exponential backoff schedule.
+func retryDelay(policy RetryPolicy, attempt int, err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+	return policy.delay(attempt)
+}
+
+// withRetry runs fn, retrying according to the client's configured
+// RetryPolicy. fn must be idempotent.
+func (c *Client) withRetry(ctx context.Context, fn func() error) error {
+	policy := c.retryPolicy
+	if policy.MaxAttempts <= 0 {
+		policy = defaultRetryPolicy
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+		if attempt == policy.MaxAttempts-1 || !policy.shouldRetry(lastErr) {
+			return lastErr
+		}
+		c.log().Warn("retrying espresso request", "attempt", attempt+1, "error", lastErr)
+		select {
+		case <-time.After(retryDelay(policy, attempt, lastErr)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return lastErr
+}
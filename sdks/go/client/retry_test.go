@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithRetrySucceedsAfterTransientError(t *testing.T) {
+	c := &Client{retryPolicy: RetryPolicy{MaxAttempts: 3}}
+
+	attempts := 0
+	err := c.withRetry(context.Background(), func() error {
+		attempts++
+		if attempts < 2 {
+			return &APIError{StatusCode: 503}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWithRetryDoesNotRetryNonRetryableError(t *testing.T) {
+	c := &Client{retryPolicy: RetryPolicy{MaxAttempts: 3}}
+
+	attempts := 0
+	err := c.withRetry(context.Background(), func() error {
+		attempts++
+		return &APIError{StatusCode: 404}
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt for a non-retryable error, got %d", attempts)
+	}
+}
+
+func TestRetryDelayHonorsRetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}
+	err := &APIError{StatusCode: 429, RetryAfter: 5 * time.Second}
+
+	if got := retryDelay(policy, 0, err); got != 5*time.Second {
+		t.Fatalf("got %v, want 5s", got)
+	}
+}
+
+func TestRetryDelayFallsBackToPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: 10 * time.Millisecond}
+	err := &APIError{StatusCode: 503}
+
+	if got := retryDelay(policy, 0, err); got != policy.delay(0) {
+		t.Fatalf("got %v, want %v", got, policy.delay(0))
+	}
+}
+
+func TestWithRetryPassesThroughNonAPIErrors(t *testing.T) {
+	c := &Client{retryPolicy: RetryPolicy{MaxAttempts: 3}}
+	sentinel := errors.New("boom")
+
+	attempts := 0
+	err := c.withRetry(context.Background(), func() error {
+		attempts++
+		return sentinel
+	})
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("expected sentinel error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// sdkVersion is the SDK's own release version, bumped alongside tagged
+// releases of this module. It is distinct from APIVersion, which identifies
+// the query service's API revision rather than the SDK's.
+const sdkVersion = "0.1.0"
+
+// Version returns the SDK's release version, e.g. for logging alongside a
+// query service's reported version when diagnosing incompatibilities.
+func Version() string {
+	return sdkVersion
+}
+
+// userAgent is sent as the User-Agent header on every request so query-node
+// operators can correlate SDK and node versions when debugging.
+func userAgent() string {
+	return fmt.Sprintf("espresso-go-sdk/%s", sdkVersion)
+}
+
+// FetchServerVersion returns the query service's own reported API version,
+// for comparing against Version() when diagnosing SDK/node incompatibilities.
+func (c *Client) FetchServerVersion(ctx context.Context) (string, error) {
+	var resp struct {
+		Version APIVersion `json:"version"`
+	}
+	if err := c.get(ctx, "/version", &resp); err != nil {
+		return "", err
+	}
+	return string(resp.Version), nil
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUserAgentHeaderSentByDefault(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Write([]byte("1"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	var height uint64
+	if err := c.get(context.Background(), "/status/block-height", &height); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got != userAgent() {
+		t.Fatalf("got User-Agent %q, want %q", got, userAgent())
+	}
+}
+
+func TestFetchServerVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"v1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	v, err := c.FetchServerVersion(context.Background())
+	if err != nil {
+		t.Fatalf("FetchServerVersion: %v", err)
+	}
+	if v != "v1" {
+		t.Fatalf("got %q", v)
+	}
+}
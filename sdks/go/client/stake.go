@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FetchStakeTable returns the current HotShot stake table.
+func (c *Client) FetchStakeTable(ctx context.Context) ([]types.StakeTableEntry, error) {
+	var entries []types.StakeTableEntry
+	if err := c.get(ctx, "/node/stake-table", &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// FetchStakeTableAtEpoch returns the stake table as of the given epoch,
+// which may differ from the current table during epoch transitions.
+func (c *Client) FetchStakeTableAtEpoch(ctx context.Context, epoch uint64) ([]types.StakeTableEntry, error) {
+	var entries []types.StakeTableEntry
+	if err := c.get(ctx, fmt.Sprintf("/node/stake-table/%d", epoch), &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
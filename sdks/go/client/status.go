@@ -0,0 +1,28 @@
+package client
+
+import "context"
+
+// NodeStatus is the status API's view of a single node's sync state.
+type NodeStatus struct {
+	SyncedHeight uint64 `json:"synced_height"`
+	LatestHeight uint64 `json:"latest_height"`
+	Version      string `json:"version"`
+}
+
+// FetchNodeStatus returns the query service's reported sync status, block
+// height, and version.
+func (c *Client) FetchNodeStatus(ctx context.Context) (*NodeStatus, error) {
+	var status NodeStatus
+	if err := c.get(ctx, "/status", &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// Ping performs a lightweight health check against the query service,
+// returning an error if it is unreachable or unhealthy. It is intended to
+// replace ad-hoc readiness checks like shelling out to curl in test setup.
+func (c *Client) Ping(ctx context.Context) error {
+	var ok bool
+	return c.get(ctx, "/healthcheck", &ok)
+}
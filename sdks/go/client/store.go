@@ -0,0 +1,131 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// RetentionPolicy bounds how much history a Store keeps. Any limit left at
+// its zero value is treated as unbounded.
+type RetentionPolicy struct {
+	// MaxAge evicts entries older than this duration, measured from the
+	// time they were inserted into the store.
+	MaxAge time.Duration
+	// MaxHeights evicts the oldest entries once more than this many
+	// distinct heights are cached.
+	MaxHeights int
+	// MaxBytes evicts the oldest entries once the store's estimated size
+	// (in bytes of cached payloads) exceeds this limit.
+	MaxBytes int64
+}
+
+type storeEntry struct {
+	header    *types.HeaderImpl
+	size      int64
+	insertedAt time.Time
+}
+
+// Store is an in-memory cache of headers keyed by height, intended for
+// long-running indexers that repeatedly re-fetch recent history. It enforces
+// a RetentionPolicy in the background so memory usage stays bounded.
+type Store struct {
+	mu       sync.Mutex
+	policy   RetentionPolicy
+	entries  map[uint64]storeEntry
+	order    []uint64 // heights in insertion order, oldest first
+	totalLen int64
+
+	stop chan struct{}
+}
+
+// NewStore creates a Store that prunes itself according to policy every
+// pruneInterval. Callers should call Close when done to stop the background
+// pruner.
+func NewStore(policy RetentionPolicy, pruneInterval time.Duration) *Store {
+	s := &Store{
+		policy:  policy,
+		entries: map[uint64]storeEntry{},
+		stop:    make(chan struct{}),
+	}
+	if pruneInterval > 0 {
+		go s.pruneLoop(pruneInterval)
+	}
+	return s
+}
+
+// Put caches a header at its height, evicting older entries if the policy
+// is now exceeded.
+func (s *Store) Put(header *types.HeaderImpl) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := estimateSize(header)
+	if _, exists := s.entries[header.Height]; !exists {
+		s.order = append(s.order, header.Height)
+	}
+	s.entries[header.Height] = storeEntry{header: header, size: size, insertedAt: time.Now()}
+	s.totalLen += size
+	s.pruneLocked()
+}
+
+// Get returns the cached header for height, if present.
+func (s *Store) Get(height uint64) (*types.HeaderImpl, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[height]
+	if !ok {
+		return nil, false
+	}
+	return e.header, true
+}
+
+// Close stops the background pruner.
+func (s *Store) Close() {
+	close(s.stop)
+}
+
+func (s *Store) pruneLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.mu.Lock()
+			s.pruneLocked()
+			s.mu.Unlock()
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// pruneLocked evicts the oldest entries until the policy is satisfied. It
+// must be called with s.mu held.
+func (s *Store) pruneLocked() {
+	now := time.Now()
+	for len(s.order) > 0 {
+		oldest := s.order[0]
+		e, ok := s.entries[oldest]
+		if !ok {
+			s.order = s.order[1:]
+			continue
+		}
+
+		overAge := s.policy.MaxAge > 0 && now.Sub(e.insertedAt) > s.policy.MaxAge
+		overCount := s.policy.MaxHeights > 0 && len(s.entries) > s.policy.MaxHeights
+		overBytes := s.policy.MaxBytes > 0 && s.totalLen > s.policy.MaxBytes
+		if !overAge && !overCount && !overBytes {
+			return
+		}
+
+		delete(s.entries, oldest)
+		s.order = s.order[1:]
+		s.totalLen -= e.size
+	}
+}
+
+func estimateSize(h *types.HeaderImpl) int64 {
+	return int64(len(h.PayloadCommitment) + len(h.BuilderCommitment) + len(h.NsTable) + 64)
+}
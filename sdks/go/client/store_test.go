@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestStorePrunesByMaxHeights(t *testing.T) {
+	s := NewStore(RetentionPolicy{MaxHeights: 2}, 0)
+	defer s.Close()
+
+	s.Put(&types.HeaderImpl{Height: 1})
+	s.Put(&types.HeaderImpl{Height: 2})
+	s.Put(&types.HeaderImpl{Height: 3})
+
+	if _, ok := s.Get(1); ok {
+		t.Fatal("expected height 1 to be pruned")
+	}
+	if _, ok := s.Get(3); !ok {
+		t.Fatal("expected height 3 to remain cached")
+	}
+}
+
+func TestStorePrunesByMaxAge(t *testing.T) {
+	s := NewStore(RetentionPolicy{MaxAge: time.Millisecond}, 0)
+	defer s.Close()
+
+	s.Put(&types.HeaderImpl{Height: 1})
+	time.Sleep(5 * time.Millisecond)
+	s.Put(&types.HeaderImpl{Height: 2})
+
+	if _, ok := s.Get(1); ok {
+		t.Fatal("expected height 1 to have aged out")
+	}
+}
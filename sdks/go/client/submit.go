@@ -0,0 +1,237 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// TransactionHash identifies a submitted transaction for later lookup.
+type TransactionHash string
+
+// Tag decodes the transaction hash's tagged-base64 tag and value, e.g.
+// "TX~AAAA..." -> ("TX", []byte{...}). See types.Commitment.Tag's doc
+// comment for why this doesn't verify the checksum.
+func (h TransactionHash) Tag() (tag string, value []byte, err error) {
+	return types.ParseTaggedBase64(string(h))
+}
+
+// String returns h's tagged-base64 representation, e.g. for logging.
+func (h TransactionHash) String() string {
+	return string(h)
+}
+
+// MarshalText implements encoding.TextMarshaler, so a TransactionHash
+// round-trips through config files and CLI flags. See
+// types.Commitment.MarshalText's doc comment for why this is needed
+// alongside the JSON encoding the underlying string type already gets.
+func (h TransactionHash) MarshalText() ([]byte, error) {
+	return []byte(h), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler; see MarshalText.
+func (h *TransactionHash) UnmarshalText(text []byte) error {
+	*h = TransactionHash(text)
+	return nil
+}
+
+// submitResult is the shape of the sequencer's /submit response.
+type submitResult struct {
+	Hash TransactionHash `json:"hash"`
+}
+
+// TransactionStatus is the shape of the availability API's per-transaction
+// lookup, trimmed to the fields SubmitTransactionAndWait needs.
+type TransactionStatus struct {
+	Height uint64 `json:"block_height"`
+	Index  uint64 `json:"index"`
+}
+
+// SubmitTransaction submits tx to the sequencer and returns its hash. The
+// hash can be passed to FetchTransactionWithProof once the transaction has
+// been sequenced; SubmitTransactionAndWait does the waiting itself.
+func (c *Client) SubmitTransaction(ctx context.Context, tx types.Transaction) (TransactionHash, error) {
+	body, err := c.codec.Codec("").Encode(tx)
+	if err != nil {
+		return "", fmt.Errorf("espresso client: encode transaction: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+c.resolvePath("/submit/submit"), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", c.codec.Codec("").ContentType())
+	c.applyDefaultHeaders(req)
+	if err := c.sign(req); err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("espresso client: submit: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", &APIError{StatusCode: resp.StatusCode, Endpoint: "/submit/submit", RetryAfter: retryAfterDuration(resp)}
+	}
+
+	var result submitResult
+	if err := c.codec.Codec("").Decode(resp.Body, &result); err != nil {
+		return "", err
+	}
+	return result.Hash, nil
+}
+
+// BatchSubmitResult is the per-transaction outcome of SubmitTransactions,
+// preserving the input order so callers can correlate results with the
+// transactions they submitted.
+type BatchSubmitResult struct {
+	Hash TransactionHash
+	Err  error
+}
+
+// SubmitTransactions submits many transactions at once. It uses the
+// sequencer's batch submit endpoint when available; if that endpoint
+// returns 404 (older query service) it falls back to pipelining individual
+// SubmitTransaction calls so callers don't have to special-case the server
+// version themselves.
+func (c *Client) SubmitTransactions(ctx context.Context, txs []types.Transaction) ([]BatchSubmitResult, error) {
+	results, err := c.submitTransactionsBatch(ctx, txs)
+	if err == nil {
+		return results, nil
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		return nil, err
+	}
+	return c.submitTransactionsPipelined(ctx, txs), nil
+}
+
+func (c *Client) submitTransactionsBatch(ctx context.Context, txs []types.Transaction) ([]BatchSubmitResult, error) {
+	body, err := c.codec.Codec("").Encode(txs)
+	if err != nil {
+		return nil, fmt.Errorf("espresso client: encode batch: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+c.resolvePath("/submit/batch"), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", c.codec.Codec("").ContentType())
+	c.applyDefaultHeaders(req)
+	if err := c.sign(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("espresso client: submit batch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Endpoint: "/submit/batch", RetryAfter: retryAfterDuration(resp)}
+	}
+
+	var hashes []TransactionHash
+	if err := c.codec.Codec("").Decode(resp.Body, &hashes); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchSubmitResult, len(hashes))
+	for i, h := range hashes {
+		results[i] = BatchSubmitResult{Hash: h}
+	}
+	return results, nil
+}
+
+// submitTransactionsPipelined issues one HTTP request per transaction
+// without waiting for each response before sending the next, giving most of
+// the throughput benefit of a real batch endpoint on servers that lack one.
+func (c *Client) submitTransactionsPipelined(ctx context.Context, txs []types.Transaction) []BatchSubmitResult {
+	results := make([]BatchSubmitResult, len(txs))
+	done := make(chan struct{}, len(txs))
+	for i, tx := range txs {
+		go func(i int, tx types.Transaction) {
+			hash, err := c.SubmitTransaction(ctx, tx)
+			results[i] = BatchSubmitResult{Hash: hash, Err: err}
+			done <- struct{}{}
+		}(i, tx)
+	}
+	for range txs {
+		<-done
+	}
+	return results
+}
+
+// SubmitAndWaitOptions configures the polling behavior of
+// SubmitTransactionAndWait.
+type SubmitAndWaitOptions struct {
+	// PollInterval is how often to poll for inclusion. Defaults to 200ms.
+	PollInterval time.Duration
+	// Timeout bounds the total wait. Defaults to 30s.
+	Timeout time.Duration
+}
+
+func (o SubmitAndWaitOptions) withDefaults() SubmitAndWaitOptions {
+	if o.PollInterval <= 0 {
+		o.PollInterval = 200 * time.Millisecond
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 30 * time.Second
+	}
+	return o
+}
+
+// SubmitTransactionAndWait submits tx and polls until it is included in a
+// block, or until opts.Timeout elapses. This replaces the wait loop every
+// SDK consumer otherwise has to write by hand. The returned receipt's
+// Confirmation is ConfirmationHotShotFinalized, since a transaction visible
+// through the availability API has already reached HotShot finality.
+func (c *Client) SubmitTransactionAndWait(ctx context.Context, tx types.Transaction, opts SubmitAndWaitOptions) (*TransactionReceipt, error) {
+	opts = opts.withDefaults()
+
+	hash, err := c.SubmitTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := c.fetchTransactionStatus(ctx, hash)
+		if err == nil {
+			return &TransactionReceipt{
+				Hash:              hash,
+				Height:            status.Height,
+				Index:             status.Index,
+				NamespacePosition: status.Index,
+				Confirmation:      ConfirmationHotShotFinalized,
+			}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("espresso client: waiting for transaction %s: %w", hash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) fetchTransactionStatus(ctx context.Context, hash TransactionHash) (*TransactionStatus, error) {
+	var status TransactionStatus
+	if err := c.get(ctx, fmt.Sprintf("/availability/transaction/hash/%s", hash), &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
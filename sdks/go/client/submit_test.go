@@ -0,0 +1,25 @@
+package client
+
+import "testing"
+
+func TestTransactionHashTextRoundTrips(t *testing.T) {
+	h := TransactionHash("TX~AAAA")
+	text, err := h.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	if string(text) != "TX~AAAA" {
+		t.Fatalf("got %s, want TX~AAAA", text)
+	}
+
+	var decoded TransactionHash
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if decoded != h {
+		t.Fatalf("got %q, want %q", decoded, h)
+	}
+	if decoded.String() != "TX~AAAA" {
+		t.Fatalf("String: got %q, want TX~AAAA", decoded.String())
+	}
+}
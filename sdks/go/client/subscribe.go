@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// subscribePollInterval is how long SubscribeHeaders waits between polls
+// once it has caught up to the chain tip.
+const subscribePollInterval = 500 * time.Millisecond
+
+// SubscribeHeaders streams headers starting at fromHeight by polling
+// FetchHeaderByHeight, resuming from the last height it successfully
+// delivered after a failed fetch. It does not use a WebSocket or other
+// streaming endpoint; once caught up to the tip it waits
+// subscribePollInterval between polls.
+//
+// The returned channel is closed when ctx is cancelled. Errors encountered
+// while reconnecting are not fatal: the subscription keeps retrying with
+// backoff until ctx is done.
+func (c *Client) SubscribeHeaders(ctx context.Context, fromHeight uint64) <-chan *types.HeaderImpl {
+	out := make(chan *types.HeaderImpl)
+	go c.runHeaderSubscription(ctx, fromHeight, out)
+	return out
+}
+
+func (c *Client) runHeaderSubscription(ctx context.Context, fromHeight uint64, out chan<- *types.HeaderImpl) {
+	defer close(out)
+
+	next := fromHeight
+	backoff := newBackoff()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		header, err := c.FetchHeaderByHeight(ctx, next)
+		if err != nil {
+			c.log().Warn("header subscription reconnecting", "height", next, "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff.next()):
+			}
+			continue
+		}
+		backoff.reset()
+
+		select {
+		case out <- header:
+			next++
+		case <-ctx.Done():
+			return
+		}
+
+		// Avoid hammering the server once we've caught up to the tip.
+		latest, err := c.FetchLatestBlockHeight(ctx)
+		if err == nil && next > latest {
+			select {
+			case <-time.After(subscribePollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// backoff is a minimal exponential backoff helper shared by the reconnect
+// paths in this package.
+type backoff struct {
+	attempt int
+}
+
+func newBackoff() *backoff { return &backoff{} }
+
+func (b *backoff) next() time.Duration {
+	d := time.Duration(1<<uint(b.attempt)) * 100 * time.Millisecond
+	if d > 10*time.Second {
+		d = 10 * time.Second
+	}
+	b.attempt++
+	return d
+}
+
+func (b *backoff) reset() { b.attempt = 0 }
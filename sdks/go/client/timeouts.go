@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// WithMethodTimeout sets a per-request timeout for GET requests whose path
+// starts with pathPrefix, e.g. WithMethodTimeout("/availability/block", 30*
+// time.Second) for payload fetches versus a shorter default for
+// "/status/block-height". This lets callers avoid setting the underlying
+// http.Client's single Timeout to the worst case across every endpoint.
+//
+// The longest matching prefix wins when more than one is registered. A path
+// with no matching prefix falls back to the http.Client's own Timeout, if
+// any.
+func WithMethodTimeout(pathPrefix string, timeout time.Duration) Option {
+	return func(c *Client) {
+		if c.methodTimeouts == nil {
+			c.methodTimeouts = make(map[string]time.Duration)
+		}
+		c.methodTimeouts[pathPrefix] = timeout
+	}
+}
+
+// methodTimeout returns the configured timeout for path, if any, using the
+// longest matching registered prefix.
+func (c *Client) methodTimeout(path string) (time.Duration, bool) {
+	var best string
+	var bestTimeout time.Duration
+	for prefix, timeout := range c.methodTimeouts {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(best) {
+			best = prefix
+			bestTimeout = timeout
+		}
+	}
+	return bestTimeout, best != ""
+}
+
+// withMethodTimeout wraps ctx with the timeout configured for path, if any.
+// The returned cancel func must always be called.
+func (c *Client) withMethodTimeout(ctx context.Context, path string) (context.Context, context.CancelFunc) {
+	timeout, ok := c.methodTimeout(path)
+	if !ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
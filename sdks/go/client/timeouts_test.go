@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMethodTimeoutCancelsSlowRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("1"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithMethodTimeout("/status", 5*time.Millisecond))
+
+	var height uint64
+	err := c.get(context.Background(), "/status/block-height", &height)
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+}
+
+func TestMethodTimeoutLongestPrefixWins(t *testing.T) {
+	c := NewClient("http://example.invalid",
+		WithMethodTimeout("/availability", time.Second),
+		WithMethodTimeout("/availability/block", 5*time.Second),
+	)
+
+	d, ok := c.methodTimeout("/availability/block/1")
+	if !ok || d != 5*time.Second {
+		t.Fatalf("got %v, %v", d, ok)
+	}
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies spans emitted by this package in exported traces.
+const tracerName = "github.com/socialsister/espresso-sequencer/sdks/go/client"
+
+// WithTracing enables OpenTelemetry instrumentation: each plain GET request
+// starts a span, named after its endpoint, as a child of the incoming
+// context's span, and carries the corresponding trace headers so the query
+// service (if it also participates in the trace) can be correlated. GET
+// requests made with coalescing or hedging carry trace headers but start no
+// span; submit requests and gRPC calls get neither.
+func WithTracing(tp trace.TracerProvider) Option {
+	return func(c *Client) {
+		if tp == nil {
+			tp = otel.GetTracerProvider()
+		}
+		c.tracer = tp.Tracer(tracerName)
+	}
+}
+
+// startSpan begins a span for the given endpoint if tracing is enabled,
+// returning a no-op finish func otherwise so call sites don't need to
+// branch on whether tracing is configured.
+func (c *Client) startSpan(ctx context.Context, endpoint string) (context.Context, func(err error)) {
+	if c.tracer == nil {
+		return ctx, func(error) {}
+	}
+	ctx, span := c.tracer.Start(ctx, "espresso.client"+endpoint, trace.WithAttributes(
+		attribute.String("espresso.endpoint", endpoint),
+	))
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}
+}
+
+// injectTraceHeaders propagates the current span context into outgoing
+// request headers using the globally configured propagator.
+func (c *Client) injectTraceHeaders(ctx context.Context, req *http.Request) {
+	if c.tracer == nil {
+		return
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// TransactionWithProof bundles a transaction's location with the data
+// needed to verify its inclusion: the namespace proof and VID common.
+type TransactionWithProof struct {
+	Height    uint64                `json:"block_height"`
+	Index     uint64                `json:"index"`
+	Namespace types.NamespaceId     `json:"namespace"`
+	Proof     *types.NamespaceProof `json:"proof"`
+	VidCommon types.VidCommon       `json:"vid_common"`
+}
+
+// FetchTransactionWithProof looks up a transaction by hash and returns it
+// together with the namespace proof and VID common data needed to verify
+// its inclusion, matching the availability API's transaction-with-proof
+// endpoint. This halves the round trips needed for inclusion checking
+// compared to fetching the transaction and proof separately.
+func (c *Client) FetchTransactionWithProof(ctx context.Context, hash TransactionHash) (*TransactionWithProof, error) {
+	var result TransactionWithProof
+	path := fmt.Sprintf("/availability/transaction/hash/%s/with-proof", hash)
+	if err := c.get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
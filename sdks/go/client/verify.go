@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+	"github.com/socialsister/espresso-sequencer/sdks/go/verification"
+)
+
+// FetchAndVerifyTransactionsInNamespace fetches the header, VID common data,
+// and namespace proof for height, verifies the proof, and returns the
+// transactions only if verification succeeds. This is the full trust path
+// a rollup needs and previously required stitching the client and
+// verification packages together by hand.
+func (c *Client) FetchAndVerifyTransactionsInNamespace(ctx context.Context, height uint64, namespace types.NamespaceId) ([]types.Transaction, error) {
+	var headerJSON json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/header/%d", height), &headerJSON); err != nil {
+		return nil, fmt.Errorf("fetch header: %w", err)
+	}
+
+	var vidCommonJSON json.RawMessage
+	if err := c.get(ctx, fmt.Sprintf("/availability/vid/common/%d", height), &vidCommonJSON); err != nil {
+		return nil, fmt.Errorf("fetch vid common: %w", err)
+	}
+
+	var proofJSON json.RawMessage
+	var proof types.NamespaceProof
+	path := fmt.Sprintf("/availability/block/%d/namespace/%d", height, namespace)
+	if err := c.get(ctx, path, &proofJSON); err != nil {
+		return nil, fmt.Errorf("fetch namespace proof: %w", err)
+	}
+	if err := json.Unmarshal(proofJSON, &proof); err != nil {
+		return nil, fmt.Errorf("decode namespace proof: %w", err)
+	}
+
+	if err := verification.VerifyNamespace(ctx, headerJSON, vidCommonJSON, proofJSON); err != nil {
+		return nil, fmt.Errorf("verify namespace proof: %w", err)
+	}
+
+	return proof.Transactions, nil
+}
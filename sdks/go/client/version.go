@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIVersion identifies a query-service API revision. The zero value,
+// APIVersionUnversioned, keeps the SDK on the unversioned paths the query
+// service has always served.
+type APIVersion string
+
+const (
+	APIVersionUnversioned APIVersion = ""
+	APIVersionV0          APIVersion = "v0"
+	APIVersionV1          APIVersion = "v1"
+)
+
+// WithAPIVersion pins the client to a specific query-service API version
+// instead of negotiating one with NegotiateAPIVersion.
+func WithAPIVersion(v APIVersion) Option {
+	return func(c *Client) { c.apiVersion = v }
+}
+
+// NegotiateAPIVersion probes the query service's /version endpoint and pins
+// the client to whatever it reports, falling back to the unversioned paths
+// if the node predates that endpoint. Nodes upgrade independently of the
+// SDK, so hard-coding one version breaks as soon as a node moves on.
+func (c *Client) NegotiateAPIVersion(ctx context.Context) error {
+	var resp struct {
+		Version APIVersion `json:"version"`
+	}
+	err := c.get(ctx, "/version", &resp)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		c.apiVersion = APIVersionUnversioned
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("espresso client: negotiate API version: %w", err)
+	}
+
+	switch resp.Version {
+	case APIVersionUnversioned, APIVersionV0, APIVersionV1:
+		c.apiVersion = resp.Version
+		return nil
+	default:
+		return fmt.Errorf("espresso client: unsupported API version %q", resp.Version)
+	}
+}
+
+// resolvePath prefixes path with the negotiated or pinned API version, if
+// any.
+func (c *Client) resolvePath(path string) string {
+	if c.apiVersion == APIVersionUnversioned {
+		return path
+	}
+	return "/" + string(c.apiVersion) + path
+}
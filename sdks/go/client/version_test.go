@@ -0,0 +1,17 @@
+package client
+
+import "testing"
+
+func TestResolvePathUnversioned(t *testing.T) {
+	c := &Client{}
+	if got := c.resolvePath("/status/block-height"); got != "/status/block-height" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestResolvePathPinned(t *testing.T) {
+	c := &Client{apiVersion: APIVersionV1}
+	if got := c.resolvePath("/status/block-height"); got != "/v1/status/block-height" {
+		t.Fatalf("got %q", got)
+	}
+}
@@ -0,0 +1,384 @@
+// Package clientmock provides a hand-written test double for
+// client.EspressoClient, so downstream projects can unit-test their rollup
+// logic without a live dev node.
+//
+// Client embeds func fields for every method; tests set the ones they need
+// and leave the rest nil. Calling a method whose func is nil returns a
+// "not configured" error (the channel-returning methods return a closed
+// channel, and Headers an empty iterator), so unexpected calls are visible
+// instead of silently returning zero values.
+package clientmock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/client"
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// Client is a programmable fake implementing client.EspressoClient.
+type Client struct {
+	FetchLatestBlockHeightFunc func(ctx context.Context) (uint64, error)
+	FetchHeaderByHeightFunc    func(ctx context.Context, height uint64) (*types.HeaderImpl, error)
+	FetchHeaderByHashFunc      func(ctx context.Context, hash string) (*types.HeaderImpl, error)
+	FetchRawHeaderByHeightFunc func(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawHeaderByHashFunc   func(ctx context.Context, hash string) (json.RawMessage, error)
+	FetchHeadersByRangeFunc    func(ctx context.Context, from, to uint64) ([]*types.HeaderImpl, error)
+	FetchHeadersByRangeStreamFunc func(ctx context.Context, from, to uint64) (<-chan []*types.HeaderImpl, <-chan error)
+	HeadersFunc func(ctx context.Context, from, to uint64) iter.Seq[*types.HeaderImpl]
+
+	FetchBlockByHeightFunc       func(ctx context.Context, height uint64) (*client.Block, error)
+	FetchBlockByHashFunc         func(ctx context.Context, hash string) (*client.Block, error)
+	FetchRawBlockByHeightFunc    func(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawBlockByHashFunc      func(ctx context.Context, hash string) (json.RawMessage, error)
+	FetchBlockBundleFunc         func(ctx context.Context, height uint64) (*client.BlockBundle, error)
+	FetchLeafByHeightFunc        func(ctx context.Context, height uint64) (*types.Leaf, error)
+	FetchLeafRangeFunc           func(ctx context.Context, from, to uint64) ([]*types.Leaf, error)
+	FetchRawLeafByHeightFunc     func(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawNamespaceProofFunc   func(ctx context.Context, height uint64, namespace types.NamespaceId) (json.RawMessage, error)
+	FetchRawVidCommonByHeightFunc func(ctx context.Context, height uint64) (json.RawMessage, error)
+	FetchRawBlockMerkleProofFunc func(ctx context.Context, height, targetHeight uint64) (json.RawMessage, error)
+	FetchBlockSummariesFunc      func(ctx context.Context, from, to uint64) ([]client.BlockSummary, error)
+	FetchTransactionSummariesFunc func(ctx context.Context, height uint64) ([]client.TransactionSummary, error)
+	SearchFunc                   func(ctx context.Context, hash string) (*client.SearchResult, error)
+
+	FetchNodeStatusFunc    func(ctx context.Context) (*client.NodeStatus, error)
+	PingFunc               func(ctx context.Context) error
+	FetchServerVersionFunc func(ctx context.Context) (string, error)
+
+	FetchFeeBalanceFunc    func(ctx context.Context, address string) (*types.FeeAmount, error)
+	EstimateFeeFunc        func(ctx context.Context, namespace types.NamespaceId, payloadSize uint64) (*types.FeeAmount, error)
+	FetchChainConfigFunc   func(ctx context.Context, height uint64) (*types.ChainConfig, error)
+	ResolveChainConfigFunc func(ctx context.Context, height uint64, rcc types.ResolvableChainConfig) (*types.ChainConfig, error)
+
+	FetchStakeTableFunc        func(ctx context.Context) ([]types.StakeTableEntry, error)
+	FetchStakeTableAtEpochFunc func(ctx context.Context, epoch uint64) ([]types.StakeTableEntry, error)
+
+	FetchCurrentEpochFunc  func(ctx context.Context) (uint64, error)
+	FetchEpochInfoFunc     func(ctx context.Context, epoch uint64) (*types.EpochInfo, error)
+	FetchRewardAccountFunc func(ctx context.Context, address string) (*types.RewardAccountState, error)
+
+	FetchTransactionWithProofFunc              func(ctx context.Context, hash client.TransactionHash) (*client.TransactionWithProof, error)
+	FetchAndVerifyTransactionsInNamespaceFunc func(ctx context.Context, height uint64, namespace types.NamespaceId) ([]types.Transaction, error)
+
+	AwaitBlockHeightFunc func(ctx context.Context, height uint64) error
+
+	SubscribeHeadersFunc                    func(ctx context.Context, fromHeight uint64) <-chan *types.HeaderImpl
+	SubscribeTransactionsByNamespaceFunc func(ctx context.Context, namespace types.NamespaceId, fromHeight uint64) <-chan client.NamespaceTransactions
+
+	SubmitTransactionFunc        func(ctx context.Context, tx types.Transaction) (client.TransactionHash, error)
+	SubmitTransactionsFunc       func(ctx context.Context, txs []types.Transaction) ([]client.BatchSubmitResult, error)
+	SubmitTransactionAndWaitFunc func(ctx context.Context, tx types.Transaction, opts client.SubmitAndWaitOptions) (*client.TransactionReceipt, error)
+}
+
+var _ client.EspressoClient = (*Client)(nil)
+
+func unimplemented(method string) error {
+	return fmt.Errorf("clientmock: %s not configured", method)
+}
+
+func (m *Client) FetchLatestBlockHeight(ctx context.Context) (uint64, error) {
+	if m.FetchLatestBlockHeightFunc == nil {
+		return 0, unimplemented("FetchLatestBlockHeight")
+	}
+	return m.FetchLatestBlockHeightFunc(ctx)
+}
+
+func (m *Client) FetchHeaderByHeight(ctx context.Context, height uint64) (*types.HeaderImpl, error) {
+	if m.FetchHeaderByHeightFunc == nil {
+		return nil, unimplemented("FetchHeaderByHeight")
+	}
+	return m.FetchHeaderByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchHeaderByHash(ctx context.Context, hash string) (*types.HeaderImpl, error) {
+	if m.FetchHeaderByHashFunc == nil {
+		return nil, unimplemented("FetchHeaderByHash")
+	}
+	return m.FetchHeaderByHashFunc(ctx, hash)
+}
+
+func (m *Client) FetchRawHeaderByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	if m.FetchRawHeaderByHeightFunc == nil {
+		return nil, unimplemented("FetchRawHeaderByHeight")
+	}
+	return m.FetchRawHeaderByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchRawHeaderByHash(ctx context.Context, hash string) (json.RawMessage, error) {
+	if m.FetchRawHeaderByHashFunc == nil {
+		return nil, unimplemented("FetchRawHeaderByHash")
+	}
+	return m.FetchRawHeaderByHashFunc(ctx, hash)
+}
+
+func (m *Client) FetchHeadersByRange(ctx context.Context, from, to uint64) ([]*types.HeaderImpl, error) {
+	if m.FetchHeadersByRangeFunc == nil {
+		return nil, unimplemented("FetchHeadersByRange")
+	}
+	return m.FetchHeadersByRangeFunc(ctx, from, to)
+}
+
+func (m *Client) FetchHeadersByRangeStream(ctx context.Context, from, to uint64) (<-chan []*types.HeaderImpl, <-chan error) {
+	if m.FetchHeadersByRangeStreamFunc == nil {
+		errc := make(chan error, 1)
+		errc <- unimplemented("FetchHeadersByRangeStream")
+		close(errc)
+		out := make(chan []*types.HeaderImpl)
+		close(out)
+		return out, errc
+	}
+	return m.FetchHeadersByRangeStreamFunc(ctx, from, to)
+}
+
+func (m *Client) Headers(ctx context.Context, from, to uint64) iter.Seq[*types.HeaderImpl] {
+	if m.HeadersFunc == nil {
+		return func(yield func(*types.HeaderImpl) bool) {}
+	}
+	return m.HeadersFunc(ctx, from, to)
+}
+
+func (m *Client) FetchBlockByHeight(ctx context.Context, height uint64) (*client.Block, error) {
+	if m.FetchBlockByHeightFunc == nil {
+		return nil, unimplemented("FetchBlockByHeight")
+	}
+	return m.FetchBlockByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchBlockByHash(ctx context.Context, hash string) (*client.Block, error) {
+	if m.FetchBlockByHashFunc == nil {
+		return nil, unimplemented("FetchBlockByHash")
+	}
+	return m.FetchBlockByHashFunc(ctx, hash)
+}
+
+func (m *Client) FetchRawBlockByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	if m.FetchRawBlockByHeightFunc == nil {
+		return nil, unimplemented("FetchRawBlockByHeight")
+	}
+	return m.FetchRawBlockByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchRawBlockByHash(ctx context.Context, hash string) (json.RawMessage, error) {
+	if m.FetchRawBlockByHashFunc == nil {
+		return nil, unimplemented("FetchRawBlockByHash")
+	}
+	return m.FetchRawBlockByHashFunc(ctx, hash)
+}
+
+func (m *Client) FetchBlockBundle(ctx context.Context, height uint64) (*client.BlockBundle, error) {
+	if m.FetchBlockBundleFunc == nil {
+		return nil, unimplemented("FetchBlockBundle")
+	}
+	return m.FetchBlockBundleFunc(ctx, height)
+}
+
+func (m *Client) FetchLeafByHeight(ctx context.Context, height uint64) (*types.Leaf, error) {
+	if m.FetchLeafByHeightFunc == nil {
+		return nil, unimplemented("FetchLeafByHeight")
+	}
+	return m.FetchLeafByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchLeafRange(ctx context.Context, from, to uint64) ([]*types.Leaf, error) {
+	if m.FetchLeafRangeFunc == nil {
+		return nil, unimplemented("FetchLeafRange")
+	}
+	return m.FetchLeafRangeFunc(ctx, from, to)
+}
+
+func (m *Client) FetchRawLeafByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	if m.FetchRawLeafByHeightFunc == nil {
+		return nil, unimplemented("FetchRawLeafByHeight")
+	}
+	return m.FetchRawLeafByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchRawNamespaceProof(ctx context.Context, height uint64, namespace types.NamespaceId) (json.RawMessage, error) {
+	if m.FetchRawNamespaceProofFunc == nil {
+		return nil, unimplemented("FetchRawNamespaceProof")
+	}
+	return m.FetchRawNamespaceProofFunc(ctx, height, namespace)
+}
+
+func (m *Client) FetchRawVidCommonByHeight(ctx context.Context, height uint64) (json.RawMessage, error) {
+	if m.FetchRawVidCommonByHeightFunc == nil {
+		return nil, unimplemented("FetchRawVidCommonByHeight")
+	}
+	return m.FetchRawVidCommonByHeightFunc(ctx, height)
+}
+
+func (m *Client) FetchRawBlockMerkleProof(ctx context.Context, height, targetHeight uint64) (json.RawMessage, error) {
+	if m.FetchRawBlockMerkleProofFunc == nil {
+		return nil, unimplemented("FetchRawBlockMerkleProof")
+	}
+	return m.FetchRawBlockMerkleProofFunc(ctx, height, targetHeight)
+}
+
+func (m *Client) FetchBlockSummaries(ctx context.Context, from, to uint64) ([]client.BlockSummary, error) {
+	if m.FetchBlockSummariesFunc == nil {
+		return nil, unimplemented("FetchBlockSummaries")
+	}
+	return m.FetchBlockSummariesFunc(ctx, from, to)
+}
+
+func (m *Client) FetchTransactionSummaries(ctx context.Context, height uint64) ([]client.TransactionSummary, error) {
+	if m.FetchTransactionSummariesFunc == nil {
+		return nil, unimplemented("FetchTransactionSummaries")
+	}
+	return m.FetchTransactionSummariesFunc(ctx, height)
+}
+
+func (m *Client) Search(ctx context.Context, hash string) (*client.SearchResult, error) {
+	if m.SearchFunc == nil {
+		return nil, unimplemented("Search")
+	}
+	return m.SearchFunc(ctx, hash)
+}
+
+func (m *Client) FetchNodeStatus(ctx context.Context) (*client.NodeStatus, error) {
+	if m.FetchNodeStatusFunc == nil {
+		return nil, unimplemented("FetchNodeStatus")
+	}
+	return m.FetchNodeStatusFunc(ctx)
+}
+
+func (m *Client) Ping(ctx context.Context) error {
+	if m.PingFunc == nil {
+		return unimplemented("Ping")
+	}
+	return m.PingFunc(ctx)
+}
+
+func (m *Client) FetchServerVersion(ctx context.Context) (string, error) {
+	if m.FetchServerVersionFunc == nil {
+		return "", unimplemented("FetchServerVersion")
+	}
+	return m.FetchServerVersionFunc(ctx)
+}
+
+func (m *Client) FetchFeeBalance(ctx context.Context, address string) (*types.FeeAmount, error) {
+	if m.FetchFeeBalanceFunc == nil {
+		return nil, unimplemented("FetchFeeBalance")
+	}
+	return m.FetchFeeBalanceFunc(ctx, address)
+}
+
+func (m *Client) EstimateFee(ctx context.Context, namespace types.NamespaceId, payloadSize uint64) (*types.FeeAmount, error) {
+	if m.EstimateFeeFunc == nil {
+		return nil, unimplemented("EstimateFee")
+	}
+	return m.EstimateFeeFunc(ctx, namespace, payloadSize)
+}
+
+func (m *Client) FetchChainConfig(ctx context.Context, height uint64) (*types.ChainConfig, error) {
+	if m.FetchChainConfigFunc == nil {
+		return nil, unimplemented("FetchChainConfig")
+	}
+	return m.FetchChainConfigFunc(ctx, height)
+}
+
+func (m *Client) ResolveChainConfig(ctx context.Context, height uint64, rcc types.ResolvableChainConfig) (*types.ChainConfig, error) {
+	if m.ResolveChainConfigFunc == nil {
+		return nil, unimplemented("ResolveChainConfig")
+	}
+	return m.ResolveChainConfigFunc(ctx, height, rcc)
+}
+
+func (m *Client) FetchStakeTable(ctx context.Context) ([]types.StakeTableEntry, error) {
+	if m.FetchStakeTableFunc == nil {
+		return nil, unimplemented("FetchStakeTable")
+	}
+	return m.FetchStakeTableFunc(ctx)
+}
+
+func (m *Client) FetchStakeTableAtEpoch(ctx context.Context, epoch uint64) ([]types.StakeTableEntry, error) {
+	if m.FetchStakeTableAtEpochFunc == nil {
+		return nil, unimplemented("FetchStakeTableAtEpoch")
+	}
+	return m.FetchStakeTableAtEpochFunc(ctx, epoch)
+}
+
+func (m *Client) FetchCurrentEpoch(ctx context.Context) (uint64, error) {
+	if m.FetchCurrentEpochFunc == nil {
+		return 0, unimplemented("FetchCurrentEpoch")
+	}
+	return m.FetchCurrentEpochFunc(ctx)
+}
+
+func (m *Client) FetchEpochInfo(ctx context.Context, epoch uint64) (*types.EpochInfo, error) {
+	if m.FetchEpochInfoFunc == nil {
+		return nil, unimplemented("FetchEpochInfo")
+	}
+	return m.FetchEpochInfoFunc(ctx, epoch)
+}
+
+func (m *Client) FetchRewardAccount(ctx context.Context, address string) (*types.RewardAccountState, error) {
+	if m.FetchRewardAccountFunc == nil {
+		return nil, unimplemented("FetchRewardAccount")
+	}
+	return m.FetchRewardAccountFunc(ctx, address)
+}
+
+func (m *Client) FetchTransactionWithProof(ctx context.Context, hash client.TransactionHash) (*client.TransactionWithProof, error) {
+	if m.FetchTransactionWithProofFunc == nil {
+		return nil, unimplemented("FetchTransactionWithProof")
+	}
+	return m.FetchTransactionWithProofFunc(ctx, hash)
+}
+
+func (m *Client) FetchAndVerifyTransactionsInNamespace(ctx context.Context, height uint64, namespace types.NamespaceId) ([]types.Transaction, error) {
+	if m.FetchAndVerifyTransactionsInNamespaceFunc == nil {
+		return nil, unimplemented("FetchAndVerifyTransactionsInNamespace")
+	}
+	return m.FetchAndVerifyTransactionsInNamespaceFunc(ctx, height, namespace)
+}
+
+func (m *Client) AwaitBlockHeight(ctx context.Context, height uint64) error {
+	if m.AwaitBlockHeightFunc == nil {
+		return unimplemented("AwaitBlockHeight")
+	}
+	return m.AwaitBlockHeightFunc(ctx, height)
+}
+
+func (m *Client) SubscribeHeaders(ctx context.Context, fromHeight uint64) <-chan *types.HeaderImpl {
+	if m.SubscribeHeadersFunc == nil {
+		out := make(chan *types.HeaderImpl)
+		close(out)
+		return out
+	}
+	return m.SubscribeHeadersFunc(ctx, fromHeight)
+}
+
+func (m *Client) SubscribeTransactionsByNamespace(ctx context.Context, namespace types.NamespaceId, fromHeight uint64) <-chan client.NamespaceTransactions {
+	if m.SubscribeTransactionsByNamespaceFunc == nil {
+		out := make(chan client.NamespaceTransactions)
+		close(out)
+		return out
+	}
+	return m.SubscribeTransactionsByNamespaceFunc(ctx, namespace, fromHeight)
+}
+
+func (m *Client) SubmitTransaction(ctx context.Context, tx types.Transaction) (client.TransactionHash, error) {
+	if m.SubmitTransactionFunc == nil {
+		return "", unimplemented("SubmitTransaction")
+	}
+	return m.SubmitTransactionFunc(ctx, tx)
+}
+
+func (m *Client) SubmitTransactions(ctx context.Context, txs []types.Transaction) ([]client.BatchSubmitResult, error) {
+	if m.SubmitTransactionsFunc == nil {
+		return nil, unimplemented("SubmitTransactions")
+	}
+	return m.SubmitTransactionsFunc(ctx, txs)
+}
+
+func (m *Client) SubmitTransactionAndWait(ctx context.Context, tx types.Transaction, opts client.SubmitAndWaitOptions) (*client.TransactionReceipt, error) {
+	if m.SubmitTransactionAndWaitFunc == nil {
+		return nil, unimplemented("SubmitTransactionAndWait")
+	}
+	return m.SubmitTransactionAndWaitFunc(ctx, tx, opts)
+}
@@ -0,0 +1,29 @@
+package clientmock
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFetchLatestBlockHeightUsesConfiguredFunc(t *testing.T) {
+	m := &Client{
+		FetchLatestBlockHeightFunc: func(ctx context.Context) (uint64, error) {
+			return 42, nil
+		},
+	}
+
+	height, err := m.FetchLatestBlockHeight(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if height != 42 {
+		t.Fatalf("expected 42, got %d", height)
+	}
+}
+
+func TestUnconfiguredMethodErrors(t *testing.T) {
+	m := &Client{}
+	if _, err := m.FetchLatestBlockHeight(context.Background()); err == nil {
+		t.Fatal("expected an error for an unconfigured method")
+	}
+}
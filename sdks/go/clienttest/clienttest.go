@@ -0,0 +1,140 @@
+// Package clienttest provides a record/replay HTTP transport for testing
+// code built on the client package against realistic query-service
+// responses, without running a live dev node. Record once against a real
+// node with NewRecorder, check the resulting cassette directory into the
+// test fixtures, then replay it in CI with NewPlayer.
+package clienttest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// cassette is the on-disk representation of one recorded request/response
+// pair.
+type cassette struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// cassetteFilename derives a stable, filesystem-safe name for a request so
+// the same request replays the same recording across runs.
+func cassetteFilename(method, url string) string {
+	sum := sha256.Sum256([]byte(method + " " + url))
+	return fmt.Sprintf("%x.json", sum)
+}
+
+// Recorder wraps an http.RoundTripper and saves every request/response pair
+// it sees as a cassette file under Dir, for later replay with Player.
+type Recorder struct {
+	Transport http.RoundTripper
+	Dir       string
+}
+
+// NewRecorder returns a Recorder that writes cassettes to dir, creating it
+// if necessary, and forwards requests to transport. A nil transport uses
+// http.DefaultTransport.
+func NewRecorder(dir string, transport http.RoundTripper) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("clienttest: create cassette dir: %w", err)
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{Transport: transport, Dir: dir}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("clienttest: read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c := cassette{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}
+	if werr := r.write(c); werr != nil {
+		return nil, werr
+	}
+	return resp, nil
+}
+
+func (r *Recorder) write(c cassette) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("clienttest: marshal cassette: %w", err)
+	}
+	path := filepath.Join(r.Dir, cassetteFilename(c.Method, c.URL))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("clienttest: write cassette: %w", err)
+	}
+	return nil
+}
+
+// ErrNoCassette is returned by Player when a request has no matching
+// recording on disk.
+type ErrNoCassette struct {
+	Method string
+	URL    string
+}
+
+func (e *ErrNoCassette) Error() string {
+	return fmt.Sprintf("clienttest: no cassette recorded for %s %s", e.Method, e.URL)
+}
+
+// Player is an http.RoundTripper that replays cassettes recorded by
+// Recorder instead of making real requests.
+type Player struct {
+	Dir string
+}
+
+// NewPlayer returns a Player that replays cassettes from dir.
+func NewPlayer(dir string) *Player {
+	return &Player{Dir: dir}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (p *Player) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := filepath.Join(p.Dir, cassetteFilename(req.Method, req.URL.String()))
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, &ErrNoCassette{Method: req.Method, URL: req.URL.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("clienttest: read cassette: %w", err)
+	}
+
+	var c cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("clienttest: unmarshal cassette: %w", err)
+	}
+
+	return &http.Response{
+		StatusCode: c.StatusCode,
+		Status:     http.StatusText(c.StatusCode),
+		Header:     c.Header,
+		Body:       io.NopCloser(bytes.NewReader(c.Body)),
+		Request:    req,
+	}, nil
+}
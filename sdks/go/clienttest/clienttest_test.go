@@ -0,0 +1,43 @@
+package clienttest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/client"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("42"))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	recorder, err := NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+
+	recordingClient := client.NewClient(srv.URL, client.WithHTTPClient(&http.Client{Transport: recorder}))
+	height, err := recordingClient.FetchLatestBlockHeight(context.Background())
+	if err != nil {
+		t.Fatalf("FetchLatestBlockHeight (record): %v", err)
+	}
+	if height != 42 {
+		t.Fatalf("got %d", height)
+	}
+
+	replayClient := client.NewClient(srv.URL, client.WithHTTPClient(&http.Client{Transport: NewPlayer(dir)}))
+	srv.Close() // prove replay doesn't hit the network
+
+	height, err = replayClient.FetchLatestBlockHeight(context.Background())
+	if err != nil {
+		t.Fatalf("FetchLatestBlockHeight (replay): %v", err)
+	}
+	if height != 42 {
+		t.Fatalf("got %d", height)
+	}
+}
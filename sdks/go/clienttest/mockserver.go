@@ -0,0 +1,140 @@
+package clienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// MockServer is a minimal, in-memory stand-in for the Espresso query
+// service's availability, status, and submit APIs. It exists so downstream
+// projects can unit-test rollup logic against programmable blocks and
+// transactions instead of running the ~3 minute cargo dev node.
+type MockServer struct {
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	height      uint64
+	headers     map[uint64]types.HeaderImpl
+	submissions []types.Transaction
+	nextHash    int
+}
+
+// NewMockServer starts a MockServer listening on a local port. Callers must
+// Close it when done, typically via defer.
+func NewMockServer() *MockServer {
+	m := &MockServer{headers: make(map[uint64]types.HeaderImpl)}
+	m.srv = httptest.NewServer(http.HandlerFunc(m.handle))
+	return m
+}
+
+// URL returns the base URL to pass to client.NewClient.
+func (m *MockServer) URL() string {
+	return m.srv.URL
+}
+
+// Close shuts down the underlying httptest.Server.
+func (m *MockServer) Close() {
+	m.srv.Close()
+}
+
+// SetHeight sets the height reported by /status/block-height.
+func (m *MockServer) SetHeight(height uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.height = height
+}
+
+// AddHeader registers the header to serve for the given height, and raises
+// the server's reported height to at least that value.
+func (m *MockServer) AddHeader(height uint64, header types.HeaderImpl) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	header.Height = height
+	m.headers[height] = header
+	if height > m.height {
+		m.height = height
+	}
+}
+
+// Submissions returns every transaction submitted via /submit/submit, in
+// submission order.
+func (m *MockServer) Submissions() []types.Transaction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]types.Transaction, len(m.submissions))
+	copy(out, m.submissions)
+	return out
+}
+
+func (m *MockServer) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/healthcheck":
+		writeJSON(w, true)
+	case r.URL.Path == "/status/block-height":
+		m.mu.Lock()
+		height := m.height
+		m.mu.Unlock()
+		writeJSON(w, height)
+	case r.URL.Path == "/status":
+		m.mu.Lock()
+		height := m.height
+		m.mu.Unlock()
+		writeJSON(w, map[string]interface{}{
+			"synced_height": height,
+			"latest_height": height,
+			"version":       "mock",
+		})
+	case strings.HasPrefix(r.URL.Path, "/availability/header/"):
+		m.serveHeader(w, r)
+	case r.URL.Path == "/submit/submit" && r.Method == http.MethodPost:
+		m.serveSubmit(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (m *MockServer) serveHeader(w http.ResponseWriter, r *http.Request) {
+	heightStr := strings.TrimPrefix(r.URL.Path, "/availability/header/")
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	header, ok := m.headers[height]
+	m.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writeJSON(w, header)
+}
+
+func (m *MockServer) serveSubmit(w http.ResponseWriter, r *http.Request) {
+	var tx types.Transaction
+	if err := json.NewDecoder(r.Body).Decode(&tx); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	m.nextHash++
+	hash := fmt.Sprintf("mock-tx-%d", m.nextHash)
+	m.submissions = append(m.submissions, tx)
+	m.mu.Unlock()
+
+	writeJSON(w, map[string]string{"hash": hash})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,45 @@
+package clienttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/client"
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestMockServerServesProgrammedState(t *testing.T) {
+	m := NewMockServer()
+	defer m.Close()
+
+	m.AddHeader(3, types.HeaderImpl{PayloadCommitment: "deadbeef"})
+
+	c := client.NewClient(m.URL())
+
+	height, err := c.FetchLatestBlockHeight(context.Background())
+	if err != nil {
+		t.Fatalf("FetchLatestBlockHeight: %v", err)
+	}
+	if height != 3 {
+		t.Fatalf("got height %d", height)
+	}
+
+	header, err := c.FetchHeaderByHeight(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("FetchHeaderByHeight: %v", err)
+	}
+	if header.PayloadCommitment != "deadbeef" {
+		t.Fatalf("got header %+v", header)
+	}
+
+	hash, err := c.SubmitTransaction(context.Background(), types.Transaction{Namespace: 1, Payload: []byte("hi")})
+	if err != nil {
+		t.Fatalf("SubmitTransaction: %v", err)
+	}
+	if hash == "" {
+		t.Fatal("expected non-empty hash")
+	}
+	if len(m.Submissions()) != 1 {
+		t.Fatalf("got %d submissions", len(m.Submissions()))
+	}
+}
@@ -0,0 +1,86 @@
+package typesv1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// TransactionToProto converts tx to its proto representation.
+func TransactionToProto(tx types.Transaction) *Transaction {
+	return &Transaction{
+		Namespace: uint64(tx.Namespace),
+		Payload:   tx.Payload,
+	}
+}
+
+// TransactionFromProto converts pb to its native representation.
+func TransactionFromProto(pb *Transaction) types.Transaction {
+	return types.Transaction{
+		Namespace: types.NamespaceId(pb.Namespace),
+		Payload:   pb.Payload,
+	}
+}
+
+// HeaderToProto converts h to its proto representation. RawFieldsJson
+// carries h's JSON encoding (h.RawFields verbatim when h was decoded, else
+// its typed fields), so HeaderFromProto can recover every field of h,
+// including ones this message doesn't expose as a typed field; see Header's
+// doc comment in types.proto.
+func HeaderToProto(h types.HeaderImpl) (*Header, error) {
+	rawFieldsJSON, err := h.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("proto: marshal header raw fields: %w", err)
+	}
+	return &Header{
+		Height:              h.Height,
+		Timestamp:           h.Timestamp,
+		L1Head:              h.L1Head,
+		PayloadCommitment:   h.PayloadCommitment,
+		BuilderCommitment:   h.BuilderCommitment,
+		NsTable:             h.NsTable,
+		BlockMerkleTreeRoot: h.BlockMerkleRoot,
+		FeeMerkleTreeRoot:   h.FeeMerkleRoot,
+		RawFieldsJson:       rawFieldsJSON,
+	}, nil
+}
+
+// HeaderFromProto converts pb to its native representation by decoding
+// RawFieldsJson through types.HeaderImpl's own UnmarshalJSON, the same way
+// a header fetched directly from the query API would be decoded. The typed
+// fields on pb are intentionally not consulted: RawFieldsJson is the
+// source of truth HeaderToProto derived them from.
+func HeaderFromProto(pb *Header) (types.HeaderImpl, error) {
+	var h types.HeaderImpl
+	if err := json.Unmarshal(pb.RawFieldsJson, &h); err != nil {
+		return types.HeaderImpl{}, fmt.Errorf("proto: unmarshal header raw fields: %w", err)
+	}
+	return h, nil
+}
+
+// NamespaceProofToProto converts p to its proto representation.
+func NamespaceProofToProto(p types.NamespaceProof) *NamespaceProof {
+	txs := make([]*Transaction, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		txs[i] = TransactionToProto(tx)
+	}
+	return &NamespaceProof{
+		Namespace:    uint64(p.Namespace),
+		Proof:        p.Proof,
+		Transactions: txs,
+	}
+}
+
+// NamespaceProofFromProto converts pb to its native representation.
+func NamespaceProofFromProto(pb *NamespaceProof) types.NamespaceProof {
+	txs := make([]types.Transaction, len(pb.Transactions))
+	for i, tx := range pb.Transactions {
+		txs[i] = TransactionFromProto(tx)
+	}
+	return types.NamespaceProof{
+		Namespace:    types.NamespaceId(pb.Namespace),
+		Proof:        pb.Proof,
+		Transactions: txs,
+	}
+}
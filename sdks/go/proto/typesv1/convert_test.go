@@ -0,0 +1,66 @@
+package typesv1
+
+import (
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestTransactionRoundTripsThroughProto(t *testing.T) {
+	tx := types.Transaction{Namespace: 42, Payload: []byte("hello")}
+	got := TransactionFromProto(TransactionToProto(tx))
+	if got.Namespace != tx.Namespace || string(got.Payload) != string(tx.Payload) {
+		t.Fatalf("got %+v, want %+v", got, tx)
+	}
+}
+
+func TestHeaderRoundTripsThroughProto(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"timestamp": 100,
+		"l1_head": 7,
+		"payload_commitment": "p",
+		"builder_commitment": "b",
+		"ns_table": "n",
+		"block_merkle_tree_root": "r1",
+		"fee_merkle_tree_root": "r2"
+	}`)
+	var h types.HeaderImpl
+	if err := h.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	pb, err := HeaderToProto(h)
+	if err != nil {
+		t.Fatalf("HeaderToProto: %v", err)
+	}
+	if pb.Height != 5 || pb.PayloadCommitment != "p" {
+		t.Fatalf("typed fields not populated: %+v", pb)
+	}
+
+	got, err := HeaderFromProto(pb)
+	if err != nil {
+		t.Fatalf("HeaderFromProto: %v", err)
+	}
+	if got.Version != types.HeaderVersionV0 {
+		t.Fatalf("got version %q, want %q", got.Version, types.HeaderVersionV0)
+	}
+	if got.Height != h.Height || got.PayloadCommitment != h.PayloadCommitment {
+		t.Fatalf("got %+v, want %+v", got, h)
+	}
+}
+
+func TestNamespaceProofRoundTripsThroughProto(t *testing.T) {
+	p := types.NamespaceProof{
+		Namespace:    7,
+		Proof:        []byte("proof"),
+		Transactions: []types.Transaction{{Namespace: 7, Payload: []byte("tx1")}},
+	}
+	got := NamespaceProofFromProto(NamespaceProofToProto(p))
+	if got.Namespace != p.Namespace || string(got.Proof) != string(p.Proof) {
+		t.Fatalf("got %+v, want %+v", got, p)
+	}
+	if len(got.Transactions) != 1 || string(got.Transactions[0].Payload) != "tx1" {
+		t.Fatalf("transactions not preserved: %+v", got.Transactions)
+	}
+}
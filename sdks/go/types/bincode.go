@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrBincodeTruncated is returned by the Decode functions below when fewer
+// bytes remain than the value being decoded requires.
+var ErrBincodeTruncated = errors.New("types: bincode input truncated")
+
+// EncodeBincodeUint64 encodes v the way bincode::serialize does for a u64:
+// fixed-width, little-endian, 8 bytes. This is the encoding the sequencer
+// itself uses for bincode payloads (see eth_signature_key.rs's
+// bincode::serialize call and network.rs's bincode_opts, both of which use
+// bincode's fixint little-endian integer encoding rather than bincode's
+// alternate varint mode).
+func EncodeBincodeUint64(v uint64) []byte {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, v)
+	return buf
+}
+
+// DecodeBincodeUint64 decodes a value encoded by EncodeBincodeUint64,
+// returning the remaining, as-yet-undecoded bytes.
+func DecodeBincodeUint64(data []byte) (value uint64, rest []byte, err error) {
+	if len(data) < 8 {
+		return 0, nil, fmt.Errorf("%w: need 8 bytes for a u64, have %d", ErrBincodeTruncated, len(data))
+	}
+	return binary.LittleEndian.Uint64(data[:8]), data[8:], nil
+}
+
+// EncodeBincodeBytes encodes b the way bincode::serialize does for a Vec<u8>
+// or &[u8]: a bincode u64 length prefix followed by the raw bytes.
+func EncodeBincodeBytes(b []byte) []byte {
+	out := EncodeBincodeUint64(uint64(len(b)))
+	return append(out, b...)
+}
+
+// EncodeBincodeString encodes s the way bincode::serialize does for a
+// String: identical to EncodeBincodeBytes over its UTF-8 representation,
+// since bincode has no separate string framing.
+func EncodeBincodeString(s string) []byte {
+	return EncodeBincodeBytes([]byte(s))
+}
+
+// DecodeBincodeBytes decodes a value encoded by EncodeBincodeBytes or
+// EncodeBincodeString, returning the remaining, as-yet-undecoded bytes.
+func DecodeBincodeBytes(data []byte) (value []byte, rest []byte, err error) {
+	length, rest, err := DecodeBincodeUint64(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(rest)) < length {
+		return nil, nil, fmt.Errorf("%w: need %d bytes, have %d", ErrBincodeTruncated, length, len(rest))
+	}
+	return rest[:length], rest[length:], nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// Golden bytes below are hand-computed against bincode's documented wire
+// format (little-endian fixint integers, u64-length-prefixed sequences) -
+// see EncodeBincodeUint64's doc comment for why that's the relevant config.
+
+func TestEncodeBincodeUint64Golden(t *testing.T) {
+	got := EncodeBincodeUint64(1)
+	want := []byte{1, 0, 0, 0, 0, 0, 0, 0}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+
+	got = EncodeBincodeUint64(0x0102030405060708)
+	want = []byte{8, 7, 6, 5, 4, 3, 2, 1}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+}
+
+func TestEncodeBincodeBytesGolden(t *testing.T) {
+	got := EncodeBincodeBytes([]byte{0xab, 0xcd, 0xef})
+	want := []byte{3, 0, 0, 0, 0, 0, 0, 0, 0xab, 0xcd, 0xef}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+}
+
+func TestEncodeBincodeStringGolden(t *testing.T) {
+	got := EncodeBincodeString("hi")
+	want := []byte{2, 0, 0, 0, 0, 0, 0, 0, 'h', 'i'}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+}
+
+func TestBincodeRoundTrips(t *testing.T) {
+	encoded := EncodeBincodeBytes([]byte("payload"))
+	encoded = append(encoded, EncodeBincodeUint64(99)...)
+
+	value, rest, err := DecodeBincodeBytes(encoded)
+	if err != nil {
+		t.Fatalf("DecodeBincodeBytes: %v", err)
+	}
+	if string(value) != "payload" {
+		t.Fatalf("got %q, want %q", value, "payload")
+	}
+
+	n, rest, err := DecodeBincodeUint64(rest)
+	if err != nil {
+		t.Fatalf("DecodeBincodeUint64: %v", err)
+	}
+	if n != 99 {
+		t.Fatalf("got %d, want 99", n)
+	}
+	if len(rest) != 0 {
+		t.Fatalf("got %d leftover bytes, want 0", len(rest))
+	}
+}
+
+func TestDecodeBincodeUint64RejectsTruncatedInput(t *testing.T) {
+	_, _, err := DecodeBincodeUint64([]byte{1, 2, 3})
+	if !errors.Is(err, ErrBincodeTruncated) {
+		t.Fatalf("got %v, want ErrBincodeTruncated", err)
+	}
+}
+
+func TestDecodeBincodeBytesRejectsTruncatedInput(t *testing.T) {
+	_, _, err := DecodeBincodeBytes(EncodeBincodeUint64(10))
+	if !errors.Is(err, ErrBincodeTruncated) {
+		t.Fatalf("got %v, want ErrBincodeTruncated", err)
+	}
+}
@@ -0,0 +1,284 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// cbor.go implements just enough of RFC 8949 ("Concise Binary Object
+// Representation") - unsigned integers, byte strings, text strings,
+// arrays, and maps, always in the RFC's deterministic ("canonical") form -
+// to give the core query/submit types (Transaction, NamespaceProof,
+// HeaderImpl) a compact binary encoding for storage, alongside their JSON
+// one. It deliberately doesn't support negative integers, floats, or CBOR
+// tags: nothing in this SDK's types needs them, and leaving them out keeps
+// this encoder small enough to read in one sitting. No CBOR library is
+// vendored in this tree, so this is a from-scratch implementation rather
+// than a wrapper around one; cross-check it against a standard library
+// (e.g. fxamacker/cbor) before depending on exact byte-for-byte output
+// from a system this SDK doesn't control.
+const (
+	cborMajorUint  = 0
+	cborMajorBytes = 2
+	cborMajorText  = 3
+	cborMajorArray = 4
+	cborMajorMap   = 5
+)
+
+// ErrCBORTruncated is returned when a CBOR value's header claims more bytes
+// than remain in the input.
+var ErrCBORTruncated = errors.New("types: truncated cbor value")
+
+// ErrCBORUnexpectedMajorType is returned when a decoder expecting one CBOR
+// major type (e.g. a text string) encounters another.
+var ErrCBORUnexpectedMajorType = errors.New("types: unexpected cbor major type")
+
+// encodeCBORHead appends major type major and argument n to buf in RFC
+// 8949's deterministic form: the shortest encoding that represents n.
+func encodeCBORHead(buf []byte, major byte, n uint64) []byte {
+	head := major << 5
+	switch {
+	case n < 24:
+		return append(buf, head|byte(n))
+	case n <= 0xff:
+		return append(buf, head|24, byte(n))
+	case n <= 0xffff:
+		return append(buf, head|25, byte(n>>8), byte(n))
+	case n <= 0xffffffff:
+		return append(buf, head|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	default:
+		return append(buf, head|27,
+			byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32),
+			byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+// EncodeCBORUint64 encodes v as a CBOR unsigned integer.
+func EncodeCBORUint64(v uint64) []byte {
+	return encodeCBORHead(nil, cborMajorUint, v)
+}
+
+// EncodeCBORBytes encodes b as a CBOR byte string.
+func EncodeCBORBytes(b []byte) []byte {
+	return append(encodeCBORHead(nil, cborMajorBytes, uint64(len(b))), b...)
+}
+
+// EncodeCBORText encodes s as a CBOR text string.
+func EncodeCBORText(s string) []byte {
+	return append(encodeCBORHead(nil, cborMajorText, uint64(len(s))), s...)
+}
+
+// EncodeCBORArrayHeader returns the header for a CBOR array of n items; the
+// caller appends each item's own encoding immediately after.
+func EncodeCBORArrayHeader(n int) []byte {
+	return encodeCBORHead(nil, cborMajorArray, uint64(n))
+}
+
+// cborMapEntry is one key/value pair awaiting canonical ordering.
+type cborMapEntry struct {
+	key     []byte // the key's own CBOR encoding, used to sort entries
+	encoded []byte // key encoding followed by value encoding
+}
+
+// EncodeCBORTextKeyedMap builds a CBOR map from fields, sorting entries by
+// their encoded key bytes as RFC 8949 deterministic encoding requires.
+// Because each key's length header comes first, shorter keys sort before
+// longer ones ("b" before "aa"), so this is not the same as sorting the key
+// strings themselves. fields is a map rather than an ordered slice because Go
+// struct literals don't preserve field order either; determinism comes from
+// the sort, not the input order.
+func EncodeCBORTextKeyedMap(fields map[string][]byte) []byte {
+	entries := make([]cborMapEntry, 0, len(fields))
+	for k, v := range fields {
+		key := EncodeCBORText(k)
+		entries = append(entries, cborMapEntry{key: key, encoded: append(key, v...)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	buf := encodeCBORHead(nil, cborMajorMap, uint64(len(entries)))
+	for _, e := range entries {
+		buf = append(buf, e.encoded...)
+	}
+	return buf
+}
+
+// decodeCBORHead reads a CBOR head from data, returning the major type, the
+// decoded argument, and the remaining bytes after the head.
+func decodeCBORHead(data []byte) (major byte, arg uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, nil, ErrCBORTruncated
+	}
+	major = data[0] >> 5
+	info := data[0] & 0x1f
+	data = data[1:]
+	switch {
+	case info < 24:
+		return major, uint64(info), data, nil
+	case info == 24:
+		if len(data) < 1 {
+			return 0, 0, nil, ErrCBORTruncated
+		}
+		return major, uint64(data[0]), data[1:], nil
+	case info == 25:
+		if len(data) < 2 {
+			return 0, 0, nil, ErrCBORTruncated
+		}
+		return major, uint64(data[0])<<8 | uint64(data[1]), data[2:], nil
+	case info == 26:
+		if len(data) < 4 {
+			return 0, 0, nil, ErrCBORTruncated
+		}
+		v := uint64(data[0])<<24 | uint64(data[1])<<16 | uint64(data[2])<<8 | uint64(data[3])
+		return major, v, data[4:], nil
+	case info == 27:
+		if len(data) < 8 {
+			return 0, 0, nil, ErrCBORTruncated
+		}
+		var v uint64
+		for _, b := range data[:8] {
+			v = v<<8 | uint64(b)
+		}
+		return major, v, data[8:], nil
+	default:
+		return 0, 0, nil, fmt.Errorf("types: unsupported cbor additional info %d", info)
+	}
+}
+
+// DecodeCBORUint64 decodes a CBOR unsigned integer from the start of data.
+func DecodeCBORUint64(data []byte) (value uint64, rest []byte, err error) {
+	major, v, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	if major != cborMajorUint {
+		return 0, nil, fmt.Errorf("%w: got %d, want unsigned integer", ErrCBORUnexpectedMajorType, major)
+	}
+	return v, rest, nil
+}
+
+// DecodeCBORBytes decodes a CBOR byte string from the start of data.
+func DecodeCBORBytes(data []byte) (value, rest []byte, err error) {
+	major, n, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if major != cborMajorBytes {
+		return nil, nil, fmt.Errorf("%w: got %d, want byte string", ErrCBORUnexpectedMajorType, major)
+	}
+	if uint64(len(rest)) < n {
+		return nil, nil, ErrCBORTruncated
+	}
+	return rest[:n], rest[n:], nil
+}
+
+// DecodeCBORText decodes a CBOR text string from the start of data.
+func DecodeCBORText(data []byte) (value string, rest []byte, err error) {
+	major, n, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return "", nil, err
+	}
+	if major != cborMajorText {
+		return "", nil, fmt.Errorf("%w: got %d, want text string", ErrCBORUnexpectedMajorType, major)
+	}
+	if uint64(len(rest)) < n {
+		return "", nil, ErrCBORTruncated
+	}
+	return string(rest[:n]), rest[n:], nil
+}
+
+// DecodeCBORArrayHeader decodes a CBOR array header from the start of data,
+// returning the number of items that follow.
+func DecodeCBORArrayHeader(data []byte) (n int, rest []byte, err error) {
+	major, v, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	if major != cborMajorArray {
+		return 0, nil, fmt.Errorf("%w: got %d, want array", ErrCBORUnexpectedMajorType, major)
+	}
+	return int(v), rest, nil
+}
+
+// DecodeCBORMapHeader decodes a CBOR map header from the start of data,
+// returning the number of key/value pairs that follow.
+func DecodeCBORMapHeader(data []byte) (n int, rest []byte, err error) {
+	major, v, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	if major != cborMajorMap {
+		return 0, nil, fmt.Errorf("%w: got %d, want map", ErrCBORUnexpectedMajorType, major)
+	}
+	return int(v), rest, nil
+}
+
+// DecodeCBORTextKeyedMap decodes a CBOR map whose keys are all text
+// strings, the inverse of EncodeCBORTextKeyedMap. Each value is returned as
+// its own still-encoded CBOR bytes, since the map doesn't know its values'
+// types; callers decode each one with the appropriate DecodeCBOR* function.
+func DecodeCBORTextKeyedMap(data []byte) (fields map[string][]byte, rest []byte, err error) {
+	n, rest, err := DecodeCBORMapHeader(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	fields = make(map[string][]byte, n)
+	for i := 0; i < n; i++ {
+		var key string
+		key, rest, err = DecodeCBORText(rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		valueStart := rest
+		rest, err = skipCBORValue(rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields[key] = valueStart[:len(valueStart)-len(rest)]
+	}
+	return fields, rest, nil
+}
+
+// skipCBORValue advances past one CBOR value of any major type this
+// package supports, without decoding it, so DecodeCBORTextKeyedMap can
+// return each value's raw bytes for the caller to decode itself.
+func skipCBORValue(data []byte) (rest []byte, err error) {
+	major, n, rest, err := decodeCBORHead(data)
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case cborMajorUint:
+		return rest, nil
+	case cborMajorBytes, cborMajorText:
+		if uint64(len(rest)) < n {
+			return nil, ErrCBORTruncated
+		}
+		return rest[n:], nil
+	case cborMajorArray:
+		for i := uint64(0); i < n; i++ {
+			rest, err = skipCBORValue(rest)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return rest, nil
+	case cborMajorMap:
+		for i := uint64(0); i < n; i++ {
+			rest, err = skipCBORValue(rest) // key
+			if err != nil {
+				return nil, err
+			}
+			rest, err = skipCBORValue(rest) // value
+			if err != nil {
+				return nil, err
+			}
+		}
+		return rest, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrCBORUnexpectedMajorType, major)
+	}
+}
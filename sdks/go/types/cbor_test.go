@@ -0,0 +1,172 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestEncodeCBORUint64ShortestForm(t *testing.T) {
+	cases := []struct {
+		v    uint64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{23, []byte{0x17}},
+		{24, []byte{0x18, 0x18}},
+		{0xff, []byte{0x18, 0xff}},
+		{0x100, []byte{0x19, 0x01, 0x00}},
+		{0x10000, []byte{0x1a, 0x00, 0x01, 0x00, 0x00}},
+		{0x100000000, []byte{0x1b, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}},
+	}
+	for _, c := range cases {
+		got := EncodeCBORUint64(c.v)
+		if !bytes.Equal(got, c.want) {
+			t.Fatalf("EncodeCBORUint64(%d) = %x, want %x", c.v, got, c.want)
+		}
+	}
+}
+
+func TestEncodeCBORTextKeyedMapOrdersKeysCanonically(t *testing.T) {
+	got := EncodeCBORTextKeyedMap(map[string][]byte{
+		"b": EncodeCBORUint64(2),
+		"a": EncodeCBORUint64(1),
+	})
+	want := append(encodeCBORHead(nil, cborMajorMap, 2),
+		append(EncodeCBORText("a"), EncodeCBORUint64(1)...)...)
+	want = append(want, append(EncodeCBORText("b"), EncodeCBORUint64(2)...)...)
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+}
+
+func TestCBORTextKeyedMapRoundTrips(t *testing.T) {
+	encoded := EncodeCBORTextKeyedMap(map[string][]byte{
+		"namespace": EncodeCBORUint64(7),
+		"payload":   EncodeCBORBytes([]byte("hello")),
+	})
+
+	fields, rest, err := DecodeCBORTextKeyedMap(encoded)
+	if err != nil {
+		t.Fatalf("DecodeCBORTextKeyedMap: %v", err)
+	}
+	if len(rest) != 0 {
+		t.Fatalf("got %d leftover bytes, want 0", len(rest))
+	}
+
+	namespace, _, err := DecodeCBORUint64(fields["namespace"])
+	if err != nil || namespace != 7 {
+		t.Fatalf("namespace = %d, %v, want 7, nil", namespace, err)
+	}
+	payload, _, err := DecodeCBORBytes(fields["payload"])
+	if err != nil || string(payload) != "hello" {
+		t.Fatalf("payload = %q, %v, want %q, nil", payload, err, "hello")
+	}
+}
+
+func TestDecodeCBORUint64RejectsTruncatedInput(t *testing.T) {
+	_, _, err := DecodeCBORUint64([]byte{0x19, 0x01})
+	if !errors.Is(err, ErrCBORTruncated) {
+		t.Fatalf("got %v, want ErrCBORTruncated", err)
+	}
+}
+
+func TestDecodeCBORBytesRejectsWrongMajorType(t *testing.T) {
+	_, _, err := DecodeCBORBytes(EncodeCBORUint64(5))
+	if !errors.Is(err, ErrCBORUnexpectedMajorType) {
+		t.Fatalf("got %v, want ErrCBORUnexpectedMajorType", err)
+	}
+}
+
+func TestTransactionCBORRoundTrips(t *testing.T) {
+	tx := Transaction{Namespace: 42, Payload: []byte("hello world")}
+
+	encoded, err := tx.MarshalCBOR()
+	if err != nil {
+		t.Fatalf("MarshalCBOR: %v", err)
+	}
+
+	var got Transaction
+	if err := got.UnmarshalCBOR(encoded); err != nil {
+		t.Fatalf("UnmarshalCBOR: %v", err)
+	}
+	if got.Namespace != tx.Namespace || !bytes.Equal(got.Payload, tx.Payload) {
+		t.Fatalf("got %+v, want %+v", got, tx)
+	}
+}
+
+func TestNamespaceProofCBORRoundTrips(t *testing.T) {
+	p := NamespaceProof{
+		Namespace: 7,
+		Proof:     []byte("proof bytes"),
+		Transactions: []Transaction{
+			{Namespace: 7, Payload: []byte("tx1")},
+			{Namespace: 7, Payload: []byte("tx2")},
+		},
+	}
+
+	encoded, err := p.MarshalCBOR()
+	if err != nil {
+		t.Fatalf("MarshalCBOR: %v", err)
+	}
+
+	var got NamespaceProof
+	if err := got.UnmarshalCBOR(encoded); err != nil {
+		t.Fatalf("UnmarshalCBOR: %v", err)
+	}
+	if got.Namespace != p.Namespace || !bytes.Equal(got.Proof, p.Proof) {
+		t.Fatalf("got %+v, want %+v", got, p)
+	}
+	if len(got.Transactions) != len(p.Transactions) {
+		t.Fatalf("got %d transactions, want %d", len(got.Transactions), len(p.Transactions))
+	}
+	for i := range p.Transactions {
+		if got.Transactions[i].Namespace != p.Transactions[i].Namespace ||
+			!bytes.Equal(got.Transactions[i].Payload, p.Transactions[i].Payload) {
+			t.Fatalf("transactions[%d] = %+v, want %+v", i, got.Transactions[i], p.Transactions[i])
+		}
+	}
+}
+
+func TestHeaderImplCBORRoundTrips(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"timestamp": 100,
+		"l1_head": 7,
+		"payload_commitment": "p",
+		"builder_commitment": "b",
+		"ns_table": "n",
+		"block_merkle_tree_root": "r1",
+		"fee_merkle_tree_root": "r2"
+	}`)
+	var h HeaderImpl
+	if err := h.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	encoded, err := h.MarshalCBOR()
+	if err != nil {
+		t.Fatalf("MarshalCBOR: %v", err)
+	}
+
+	var got HeaderImpl
+	if err := got.UnmarshalCBOR(encoded); err != nil {
+		t.Fatalf("UnmarshalCBOR: %v", err)
+	}
+	if got.Version != HeaderVersionV0 {
+		t.Fatalf("got version %q, want %q", got.Version, HeaderVersionV0)
+	}
+	if got.Height != h.Height || got.Timestamp != h.Timestamp || got.L1Head != h.L1Head ||
+		got.PayloadCommitment != h.PayloadCommitment || got.BuilderCommitment != h.BuilderCommitment ||
+		got.NsTable != h.NsTable || got.BlockMerkleRoot != h.BlockMerkleRoot ||
+		got.FeeMerkleRoot != h.FeeMerkleRoot {
+		t.Fatalf("got %+v, want %+v", got, h)
+	}
+}
+
+func TestHeaderImplMarshalCBORRejectsUnknownVersion(t *testing.T) {
+	h := HeaderImpl{Version: HeaderVersionUnknown}
+	if _, err := h.MarshalCBOR(); err == nil {
+		t.Fatal("MarshalCBOR did not reject HeaderVersionUnknown")
+	}
+}
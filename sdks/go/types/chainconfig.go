@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ChainConfig holds the Espresso chain's global parameters: its chain ID,
+// the maximum size of a block payload, and the minimum fee per byte of
+// payload. See chain_config::ChainConfig in the sequencer.
+//
+// ChainID and BaseFee reuse FeeAmount even though neither is a fee: both
+// are plain U256 newtypes in the Rust type (ChainId(U256) and
+// state::FeeAmount(U256) respectively), and this SDK has no separate U256
+// wrapper to give them instead.
+type ChainConfig struct {
+	ChainID      *FeeAmount `json:"chain_id"`
+	MaxBlockSize uint64     `json:"max_block_size"`
+	BaseFee      *FeeAmount `json:"base_fee"`
+}
+
+// ResolvableChainConfig mirrors chain_config::ResolvableChainConfig: a
+// header embeds either a full ChainConfig or just a commitment to one,
+// depending on whether the block's proposer chose to include it (typically
+// only the first block after a chain config change includes the full
+// value). Exactly one of Config and CommitmentOnly is populated after a
+// successful decode; use Resolve to read whichever one that is.
+//
+// The wire shape below comes from reading the Rust source, not from a
+// captured response: ResolvableChainConfig wraps an
+// Either<ChainConfig, Commitment<ChainConfig>> in a field also named
+// chain_config, and neither the `either` nor `committable` crate is
+// vendored in this tree to confirm their serde derive output against. This
+// SDK assumes `either`'s default externally-tagged encoding, i.e.
+// {"chain_config": {"Left": <ChainConfig>}} or
+// {"chain_config": {"Right": <commitment string>}}.
+type ResolvableChainConfig struct {
+	Config         *ChainConfig
+	CommitmentOnly Commitment
+}
+
+type resolvableChainConfigEither struct {
+	Left  *ChainConfig `json:"Left,omitempty"`
+	Right *Commitment  `json:"Right,omitempty"`
+}
+
+type resolvableChainConfigWire struct {
+	ChainConfig resolvableChainConfigEither `json:"chain_config"`
+}
+
+// MarshalJSON encodes r in the {"chain_config": {"Left": ...}} shape
+// described in ResolvableChainConfig's doc comment.
+func (r ResolvableChainConfig) MarshalJSON() ([]byte, error) {
+	var wire resolvableChainConfigWire
+	if r.Config != nil {
+		wire.ChainConfig.Left = r.Config
+	} else {
+		wire.ChainConfig.Right = &r.CommitmentOnly
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON decodes data in the shape described in
+// ResolvableChainConfig's doc comment.
+func (r *ResolvableChainConfig) UnmarshalJSON(data []byte) error {
+	var wire resolvableChainConfigWire
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	switch {
+	case wire.ChainConfig.Left != nil:
+		*r = ResolvableChainConfig{Config: wire.ChainConfig.Left}
+	case wire.ChainConfig.Right != nil:
+		*r = ResolvableChainConfig{CommitmentOnly: *wire.ChainConfig.Right}
+	default:
+		return fmt.Errorf("types: resolvable chain config has neither Left nor Right")
+	}
+	return nil
+}
+
+// Resolve returns r's ChainConfig if it was embedded directly, mirroring
+// ResolvableChainConfig::resolve in the sequencer, which returns None when
+// the header only carries a commitment. Callers that need the config in
+// that case should fetch it from the node instead; see
+// client.Client.ResolveChainConfig.
+func (r ResolvableChainConfig) Resolve() (*ChainConfig, bool) {
+	if r.Config == nil {
+		return nil, false
+	}
+	return r.Config, true
+}
@@ -0,0 +1,51 @@
+package types
+
+import "testing"
+
+func TestResolvableChainConfigRoundTripsFullConfig(t *testing.T) {
+	rcc := ResolvableChainConfig{
+		Config: &ChainConfig{ChainID: NewFeeAmount(35353), MaxBlockSize: 10240, BaseFee: NewFeeAmount(0)},
+	}
+	data, err := rcc.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var decoded ResolvableChainConfig
+	if err := decoded.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	cfg, ok := decoded.Resolve()
+	if !ok {
+		t.Fatal("Resolve() = false, want true")
+	}
+	if cfg.MaxBlockSize != 10240 {
+		t.Fatalf("got max block size %d, want 10240", cfg.MaxBlockSize)
+	}
+}
+
+func TestResolvableChainConfigRoundTripsCommitmentOnly(t *testing.T) {
+	rcc := ResolvableChainConfig{CommitmentOnly: Commitment("CHAIN_CONFIG~AAAA")}
+	data, err := rcc.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var decoded ResolvableChainConfig
+	if err := decoded.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if _, ok := decoded.Resolve(); ok {
+		t.Fatal("Resolve() = true, want false")
+	}
+	if decoded.CommitmentOnly != rcc.CommitmentOnly {
+		t.Fatalf("got %q, want %q", decoded.CommitmentOnly, rcc.CommitmentOnly)
+	}
+}
+
+func TestResolvableChainConfigUnmarshalRejectsNeitherVariant(t *testing.T) {
+	var decoded ResolvableChainConfig
+	if err := decoded.UnmarshalJSON([]byte(`{"chain_config":{}}`)); err == nil {
+		t.Fatal("got nil error, want one for a missing Left/Right")
+	}
+}
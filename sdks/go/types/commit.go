@@ -0,0 +1,97 @@
+package types
+
+import "errors"
+
+// Committable is implemented by every SDK type with a known counterpart to
+// the Rust `committable` crate's `Committable` trait, so Go and Rust agree
+// on what identifies a value. Unlike the Rust trait's infallible
+// `commit(&self) -> Commitment<Self>`, Commit here can fail: the
+// `committable` crate's RawCommitmentBuilder byte encoding isn't vendored
+// in this tree (see ErrHeaderCommitUnsupported), so every implementation
+// below returns an error until that's confirmed against real output,
+// rather than silently producing a commitment that doesn't match the node.
+type Committable interface {
+	Commit() (Commitment, error)
+}
+
+var (
+	_ Committable = HeaderImpl{}
+	_ Committable = Transaction{}
+	_ Committable = FeeInfo{}
+	_ Committable = ChainConfig{}
+)
+
+// ErrHeaderCommitUnsupported is returned by HeaderImpl.Commit. The
+// sequencer's header commitment (sequencer/src/header.rs, Committable for
+// Header) folds in chain_config.commit() and fee_info.commit() - HeaderImpl
+// has no fee_info field, and ChainConfig.Commit is itself unsupported - and
+// is built with the external `committable`
+// crate's RawCommitmentBuilder, whose exact tagged-hash byte encoding isn't
+// vendored anywhere in this tree and can't be fetched in this environment
+// to check a Go port against. Producing a commitment that merely looks
+// plausible, with no way to verify it against the real node or the light
+// client contract, would be worse than refusing: callers would trust a
+// value that silently doesn't match. Commit returns this error until
+// HeaderImpl carries fee_info and the builder's encoding has
+// been confirmed against real, node-produced header commitments.
+var ErrHeaderCommitUnsupported = errors.New("types: HeaderImpl.Commit is not yet implemented (see ErrHeaderCommitUnsupported doc comment)")
+
+// Commit is meant to reproduce the sequencer's Committable implementation
+// for Header (the hash the light client contract stores per block) in pure
+// Go, so callers can check a header against that contract without the FFI.
+// It is not implemented yet; see ErrHeaderCommitUnsupported.
+//
+// Note for anyone tempted to build this on EncodeBincodeUint64 and friends
+// (bincode.go): Header, Transaction, and ChainConfig commitments in this
+// codebase are not bincode payloads. They go through the `committable`
+// crate's RawCommitmentBuilder, a separate tagged-hash scheme (see the
+// doc comment above). Bincode is real in this codebase, but it's used
+// elsewhere, for signature and network-message serialization (see
+// eth_signature_key.rs and network.rs) - unrelated to commitments.
+func (h HeaderImpl) Commit() (Commitment, error) {
+	return "", ErrHeaderCommitUnsupported
+}
+
+// ErrTransactionCommitUnsupported is returned by Transaction.Commit, for
+// the same reason as ErrHeaderCommitUnsupported: the exact formula is known
+// (sequencer/src/transaction.rs's Committable impl tags the commitment
+// "TX" and folds in a u64 field for the namespace and a variable-size-bytes
+// field for the payload), but RawCommitmentBuilder's byte encoding of those
+// fields isn't vendored here to check a Go port against.
+var ErrTransactionCommitUnsupported = errors.New("types: Transaction.Commit is not yet implemented (see ErrTransactionCommitUnsupported doc comment)")
+
+// Commit is meant to reproduce the sequencer's Committable implementation
+// for Transaction. It is not implemented yet; see
+// ErrTransactionCommitUnsupported.
+func (tx Transaction) Commit() (Commitment, error) {
+	return "", ErrTransactionCommitUnsupported
+}
+
+// ErrFeeInfoCommitUnsupported is returned by FeeInfo.Commit, for the same
+// reason as ErrHeaderCommitUnsupported: the formula is known
+// (sequencer/src/state.rs's Committable impl tags the commitment
+// "FEE_INFO" and folds in fixed-size-bytes fields for the 20-byte account
+// address and the 32-byte amount), but RawCommitmentBuilder's byte encoding
+// of those fields isn't vendored here to check a Go port against.
+var ErrFeeInfoCommitUnsupported = errors.New("types: FeeInfo.Commit is not yet implemented (see ErrFeeInfoCommitUnsupported doc comment)")
+
+// Commit is meant to reproduce the sequencer's Committable implementation
+// for FeeInfo. It is not implemented yet; see ErrFeeInfoCommitUnsupported.
+func (f FeeInfo) Commit() (Commitment, error) {
+	return "", ErrFeeInfoCommitUnsupported
+}
+
+// ErrChainConfigCommitUnsupported is returned by ChainConfig.Commit, for
+// the same reason as ErrHeaderCommitUnsupported: the formula is known
+// (chain_config.rs's Committable impl tags the commitment "CHAIN_CONFIG"
+// and folds in a fixed-size-bytes chain ID, a u64 max block size, and a
+// fixed-size-bytes base fee), but RawCommitmentBuilder's byte encoding of
+// those fields isn't vendored here to check a Go port against.
+var ErrChainConfigCommitUnsupported = errors.New("types: ChainConfig.Commit is not yet implemented (see ErrChainConfigCommitUnsupported doc comment)")
+
+// Commit is meant to reproduce the sequencer's Committable implementation
+// for ChainConfig. It is not implemented yet; see
+// ErrChainConfigCommitUnsupported.
+func (cc ChainConfig) Commit() (Commitment, error) {
+	return "", ErrChainConfigCommitUnsupported
+}
@@ -0,0 +1,41 @@
+package types
+
+import "testing"
+
+// TestHeaderCommitIsUnimplemented pins down that Commit fails loudly
+// rather than returning a plausible-looking but unverified hash. See
+// ErrHeaderCommitUnsupported's doc comment for why: this SDK can't check a
+// Go port of the sequencer's Committable encoding against real,
+// node-produced headers in this environment.
+func TestHeaderCommitIsUnimplemented(t *testing.T) {
+	_, err := HeaderImpl{Height: 1}.Commit()
+	if err != ErrHeaderCommitUnsupported {
+		t.Fatalf("got %v, want ErrHeaderCommitUnsupported", err)
+	}
+}
+
+// TestTransactionCommitIsUnimplemented, TestFeeInfoCommitIsUnimplemented and
+// TestChainConfigCommitIsUnimplemented pin down the same refusal as
+// TestHeaderCommitIsUnimplemented, for the other three Committable
+// implementations in this package.
+func TestTransactionCommitIsUnimplemented(t *testing.T) {
+	_, err := Transaction{Namespace: 1, Payload: []byte("x")}.Commit()
+	if err != ErrTransactionCommitUnsupported {
+		t.Fatalf("got %v, want ErrTransactionCommitUnsupported", err)
+	}
+}
+
+func TestFeeInfoCommitIsUnimplemented(t *testing.T) {
+	_, err := FeeInfo{Account: "0x0", Amount: NewFeeAmount(0)}.Commit()
+	if err != ErrFeeInfoCommitUnsupported {
+		t.Fatalf("got %v, want ErrFeeInfoCommitUnsupported", err)
+	}
+}
+
+func TestChainConfigCommitIsUnimplemented(t *testing.T) {
+	cfg := ChainConfig{ChainID: NewFeeAmount(35353), MaxBlockSize: 10240, BaseFee: NewFeeAmount(0)}
+	_, err := cfg.Commit()
+	if err != ErrChainConfigCommitUnsupported {
+		t.Fatalf("got %v, want ErrChainConfigCommitUnsupported", err)
+	}
+}
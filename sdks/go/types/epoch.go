@@ -0,0 +1,63 @@
+package types
+
+// EpochInfo describes the current epoch and its height boundaries.
+type EpochInfo struct {
+	Epoch       uint64 `json:"epoch"`
+	StartHeight uint64 `json:"start_height"`
+	EndHeight   uint64 `json:"end_height"`
+}
+
+// RewardAccountState is the address and reward balance of a single staking
+// account, as tracked by the reward merkle tree.
+type RewardAccountState struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// RewardAccountProof attests that Account is included, with the state given,
+// in the reward merkle tree rooted at a HeaderVersionV3 header's
+// EpochHeaderFields.RewardMerkleRoot.
+//
+// Like EpochHeaderFields, the sequencer's reward-accounting design isn't
+// part of the Rust source vendored in this tree, so this shape is this
+// SDK's own forward-looking projection rather than a port of a real Rust
+// struct - modeled on BlockMerkleProof, the one merkle-proof shape this SDK
+// has actually confirmed against source, since a reward account proof is
+// the same kind of thing: a path of sibling hashes up from a leaf to a
+// root. Treat it as provisional until checked against real captured
+// reward proofs once a v3 node is reachable.
+type RewardAccountProof struct {
+	Account RewardAccountState `json:"account"`
+	Path    []string           `json:"path"`
+}
+
+// PathLength returns the number of sibling hashes in the proof; see
+// BlockMerkleProof.PathLength for why this is the one property of Path
+// that's useful to inspect without knowing how to decode its entries.
+func (p RewardAccountProof) PathLength() int {
+	return len(p.Path)
+}
+
+// Clone returns a deep copy of p: mutating the result's Path doesn't
+// affect p's, and vice versa.
+func (p RewardAccountProof) Clone() RewardAccountProof {
+	clone := p
+	if p.Path != nil {
+		clone.Path = append([]string(nil), p.Path...)
+	}
+	return clone
+}
+
+// Equal reports whether p and other attest to the same account along the
+// same path.
+func (p RewardAccountProof) Equal(other RewardAccountProof) bool {
+	if p.Account != other.Account || len(p.Path) != len(other.Path) {
+		return false
+	}
+	for i := range p.Path {
+		if p.Path[i] != other.Path[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+package types
+
+import "testing"
+
+func TestRewardAccountProofCloneIsIndependent(t *testing.T) {
+	p := RewardAccountProof{
+		Account: RewardAccountState{Address: "0xabc", Balance: "100"},
+		Path:    []string{"a", "b"},
+	}
+	clone := p.Clone()
+	clone.Path[0] = "z"
+
+	if p.Path[0] != "a" {
+		t.Fatalf("mutating the clone's Path changed the original: %v", p.Path)
+	}
+	want := RewardAccountProof{
+		Account: RewardAccountState{Address: "0xabc", Balance: "100"},
+		Path:    []string{"a", "b"},
+	}
+	if !p.Equal(want) {
+		t.Fatalf("original should still equal its unmodified value")
+	}
+	if p.Equal(clone) {
+		t.Fatalf("mutated clone should no longer equal the original")
+	}
+}
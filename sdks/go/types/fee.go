@@ -0,0 +1,15 @@
+package types
+
+// FeeAccount is an Ethereum address identifying a fee-paying or
+// fee-receiving account, hex-encoded with a "0x" prefix, mirroring the
+// sequencer's FeeAccount (a newtype over an Ethereum Address; see
+// sequencer/src/state.rs).
+type FeeAccount string
+
+// FeeInfo pairs a fee account with the amount charged or credited to it,
+// mirroring the sequencer's FeeInfo (see sequencer/src/state.rs). See
+// FeeAmount for why Amount isn't a plain string or uint64.
+type FeeInfo struct {
+	Account FeeAccount `json:"account"`
+	Amount  *FeeAmount `json:"amount"`
+}
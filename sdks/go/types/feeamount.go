@@ -0,0 +1,143 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// maxFeeAmount is the largest value a FeeAmount can hold: 2^256 - 1, the
+// range of the sequencer's FeeAmount (a newtype over U256; see
+// sequencer/src/state.rs).
+var maxFeeAmount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+var (
+	// ErrInvalidFeeAmount is returned by ParseFeeAmount when the input isn't
+	// a valid decimal or "0x"-prefixed hex integer.
+	ErrInvalidFeeAmount = errors.New("types: invalid fee amount")
+	// ErrFeeAmountOutOfRange is returned when a FeeAmount would be negative
+	// or would exceed the 256-bit range real FeeAmount values are stored in.
+	ErrFeeAmountOutOfRange = errors.New("types: fee amount out of range for a 256-bit unsigned integer")
+	// ErrFeeAmountUnderflow is returned by FeeAmount.CheckedSub when
+	// subtracting would produce a negative result; FeeAmount, like the
+	// sequencer's, is unsigned.
+	ErrFeeAmountUnderflow = errors.New("types: fee amount underflow")
+)
+
+// FeeAmount is a fee amount in wei, backed by a 256-bit unsigned integer,
+// mirroring the sequencer's FeeAmount (a newtype over U256; see
+// sequencer/src/state.rs). The zero value is zero.
+//
+// FeeAmount is defined as a named big.Int type, the same technique
+// go-ethereum's hexutil.Big uses, so BigInt can hand back a *big.Int
+// without copying: FeeAmount and big.Int share the same memory layout.
+type FeeAmount big.Int
+
+// NewFeeAmount returns a FeeAmount representing v. v must be non-negative;
+// NewFeeAmount panics otherwise, the same way big.NewInt's callers are
+// expected to pass a value that's already known valid. Use
+// FeeAmountFromUint64 when v comes from an untrusted or unsigned source.
+func NewFeeAmount(v int64) *FeeAmount {
+	if v < 0 {
+		panic("types: NewFeeAmount called with a negative value")
+	}
+	return (*FeeAmount)(big.NewInt(v))
+}
+
+// FeeAmountFromUint64 returns a FeeAmount representing v. Unlike converting
+// through int64, this can't silently wrap a large uint64 into a negative
+// value.
+func FeeAmountFromUint64(v uint64) *FeeAmount {
+	return (*FeeAmount)(new(big.Int).SetUint64(v))
+}
+
+// ParseFeeAmount parses s as a FeeAmount. s may be a plain decimal integer
+// (as returned by the catchup API's fee-balance and chain-config endpoints)
+// or a "0x"-prefixed hexadecimal integer.
+func ParseFeeAmount(s string) (*FeeAmount, error) {
+	base := 10
+	digits := s
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		base = 16
+		digits = s[2:]
+	}
+
+	i, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFeeAmount, s)
+	}
+	if i.Sign() < 0 || i.Cmp(maxFeeAmount) > 0 {
+		return nil, fmt.Errorf("%w: %q", ErrFeeAmountOutOfRange, s)
+	}
+	return (*FeeAmount)(i), nil
+}
+
+// BigInt returns f as a *big.Int. The returned value shares f's
+// underlying storage; callers that need to mutate it should take a copy
+// first.
+func (f *FeeAmount) BigInt() *big.Int {
+	return (*big.Int)(f)
+}
+
+// Uint64 returns f as a uint64, and false if f is too large to fit,
+// instead of silently truncating the way a bare uint64(bigInt.Int64())
+// conversion would.
+func (f *FeeAmount) Uint64() (uint64, bool) {
+	if !f.BigInt().IsUint64() {
+		return 0, false
+	}
+	return f.BigInt().Uint64(), true
+}
+
+// String returns f's decimal representation.
+func (f *FeeAmount) String() string {
+	return f.BigInt().String()
+}
+
+// Add returns f + other as a new FeeAmount. Overflow past the 256-bit range
+// isn't possible for realistic fee values and isn't checked here; use
+// CheckedSub for the direction that can actually go out of range.
+func (f *FeeAmount) Add(other *FeeAmount) *FeeAmount {
+	return (*FeeAmount)(new(big.Int).Add(f.BigInt(), other.BigInt()))
+}
+
+// Mul returns f * n as a new FeeAmount, for scaling a per-byte fee rate by a
+// payload size without the int64 overflow a bare big.NewInt(int64(n)) risks
+// for n close to math.MaxUint64.
+func (f *FeeAmount) Mul(n uint64) *FeeAmount {
+	return (*FeeAmount)(new(big.Int).Mul(f.BigInt(), new(big.Int).SetUint64(n)))
+}
+
+// CheckedSub returns f - other, and ErrFeeAmountUnderflow if that would be
+// negative, mirroring the sequencer's CheckedSub impl for FeeAmount (see
+// sequencer/src/state.rs) instead of silently wrapping the way an
+// unchecked subtraction on an unsigned type would.
+func (f *FeeAmount) CheckedSub(other *FeeAmount) (*FeeAmount, error) {
+	result := new(big.Int).Sub(f.BigInt(), other.BigInt())
+	if result.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s - %s", ErrFeeAmountUnderflow, f, other)
+	}
+	return (*FeeAmount)(result), nil
+}
+
+// MarshalJSON encodes f as a decimal string, matching the wire shape of the
+// catchup API's fee-balance and chain-config endpoints.
+func (f *FeeAmount) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + f.String() + `"`), nil
+}
+
+// UnmarshalJSON decodes a FeeAmount from a JSON string, accepting either the
+// decimal or "0x"-prefixed hex forms ParseFeeAmount does.
+func (f *FeeAmount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return fmt.Errorf("%w: %s", ErrInvalidFeeAmount, data)
+	}
+	parsed, err := ParseFeeAmount(s[1 : len(s)-1])
+	if err != nil {
+		return err
+	}
+	*f = *parsed
+	return nil
+}
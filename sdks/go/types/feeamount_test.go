@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseFeeAmountDecimalAndHex(t *testing.T) {
+	dec, err := ParseFeeAmount("1000")
+	if err != nil {
+		t.Fatalf("ParseFeeAmount(decimal): %v", err)
+	}
+	hex, err := ParseFeeAmount("0x3e8")
+	if err != nil {
+		t.Fatalf("ParseFeeAmount(hex): %v", err)
+	}
+	if dec.BigInt().Cmp(hex.BigInt()) != 0 {
+		t.Fatalf("got %s and %s, want equal", dec, hex)
+	}
+}
+
+func TestParseFeeAmountRejectsNegativeAndInvalid(t *testing.T) {
+	if _, err := ParseFeeAmount("-1"); !errors.Is(err, ErrFeeAmountOutOfRange) {
+		t.Fatalf("got %v, want ErrFeeAmountOutOfRange", err)
+	}
+	if _, err := ParseFeeAmount("not-a-number"); !errors.Is(err, ErrInvalidFeeAmount) {
+		t.Fatalf("got %v, want ErrInvalidFeeAmount", err)
+	}
+}
+
+func TestFeeAmountFromUint64DoesNotWrapNegative(t *testing.T) {
+	f := FeeAmountFromUint64(1 << 63)
+	got, ok := f.Uint64()
+	if !ok || got != 1<<63 {
+		t.Fatalf("got (%d, %v), want (%d, true)", got, ok, uint64(1)<<63)
+	}
+}
+
+func TestFeeAmountUint64FalseWhenTooLarge(t *testing.T) {
+	f, err := ParseFeeAmount("0x10000000000000000") // 2^64
+	if err != nil {
+		t.Fatalf("ParseFeeAmount: %v", err)
+	}
+	if _, ok := f.Uint64(); ok {
+		t.Fatal("Uint64: got ok=true for a value larger than math.MaxUint64, want false")
+	}
+}
+
+func TestFeeAmountMulAvoidsInt64Overflow(t *testing.T) {
+	f := NewFeeAmount(2)
+	got := f.Mul(1 << 63)
+	want, _ := ParseFeeAmount("18446744073709551616") // 2 * 2^63
+	if got.BigInt().Cmp(want.BigInt()) != 0 {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestFeeAmountCheckedSub(t *testing.T) {
+	a := NewFeeAmount(5)
+	b := NewFeeAmount(3)
+
+	diff, err := a.CheckedSub(b)
+	if err != nil {
+		t.Fatalf("CheckedSub: %v", err)
+	}
+	if diff.String() != "2" {
+		t.Fatalf("got %s, want 2", diff)
+	}
+
+	if _, err := b.CheckedSub(a); !errors.Is(err, ErrFeeAmountUnderflow) {
+		t.Fatalf("got %v, want ErrFeeAmountUnderflow", err)
+	}
+}
+
+func TestFeeAmountJSONRoundTrips(t *testing.T) {
+	f := NewFeeAmount(42)
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"42"` {
+		t.Fatalf("got %s, want \"42\"", data)
+	}
+
+	var decoded FeeAmount
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.String() != "42" {
+		t.Fatalf("got %s, want 42", &decoded)
+	}
+}
+
+func TestFeeAmountUnmarshalJSONAcceptsHex(t *testing.T) {
+	var decoded FeeAmount
+	if err := json.Unmarshal([]byte(`"0x2a"`), &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.String() != "42" {
+		t.Fatalf("got %s, want 42", &decoded)
+	}
+}
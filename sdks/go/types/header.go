@@ -0,0 +1,426 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// HeaderVersion identifies the header shape HeaderImpl.UnmarshalJSON
+// recognized a decoded payload as.
+type HeaderVersion string
+
+const (
+	// HeaderVersionV0 is reported when a header's JSON contains all of the
+	// fields HeaderImpl currently exposes, so they were decoded into it.
+	HeaderVersionV0 HeaderVersion = "v0"
+	// HeaderVersionV3 is reported when a header's JSON contains all of the
+	// HeaderVersionV0 fields plus the epoch-era fields in
+	// EpochHeaderFields, so both were decoded.
+	HeaderVersionV3 HeaderVersion = "v3"
+	// HeaderVersionUnknown is reported when a header's JSON didn't contain
+	// all of HeaderImpl's fields, most likely because the node has moved on
+	// to a header version newer than this SDK understands. HeaderImpl's
+	// typed fields are left zero-valued; RawFields still holds everything
+	// the payload actually contained.
+	HeaderVersionUnknown HeaderVersion = "unknown"
+)
+
+// headerV0Fields lists the JSON keys UnmarshalJSON requires, all present,
+// before it reports HeaderVersionV0. Keep this in sync with the json tags on
+// HeaderImpl's fields in types.go.
+var headerV0Fields = []string{
+	"height",
+	"timestamp",
+	"l1_head",
+	"payload_commitment",
+	"builder_commitment",
+	"ns_table",
+	"block_merkle_tree_root",
+	"fee_merkle_tree_root",
+}
+
+// headerV3Fields lists the additional JSON keys, beyond headerV0Fields,
+// UnmarshalJSON requires before it reports HeaderVersionV3. Keep this in
+// sync with the json tags on EpochHeaderFields in header_v3.go.
+var headerV3Fields = []string{
+	"epoch",
+	"stake_table_commitment",
+	"reward_merkle_tree_root",
+}
+
+// headerFieldOrder lists Header's fields (see sequencer/src/header.rs) in
+// the order #[derive(Serialize)] emits them: struct declaration order, since
+// Header has no field-level rename or skip_serializing_if. MarshalJSON uses
+// this to reproduce that order regardless of the declaration order of
+// HeaderImpl's own fields (chosen for readability, not wire compatibility)
+// or of Go map iteration. builder_signature and fee_info have no typed
+// HeaderImpl field yet, but are listed here so a decoded header's RawFields
+// still re-emit them in the right place.
+var headerFieldOrder = []string{
+	"chain_config",
+	"height",
+	"timestamp",
+	"l1_head",
+	"l1_finalized",
+	"payload_commitment",
+	"builder_commitment",
+	"ns_table",
+	"block_merkle_tree_root",
+	"fee_merkle_tree_root",
+	"builder_signature",
+	"fee_info",
+}
+
+// marshalRawFieldsInOrder serializes raw as a JSON object, placing each key
+// listed in order first (in that order, and only if present in raw), then
+// any of raw's remaining keys afterward sorted alphabetically for
+// determinism. Every value is written using its original raw bytes, so
+// number formatting and string escaping survive unchanged.
+func marshalRawFieldsInOrder(raw map[string]json.RawMessage, order []string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	written := make(map[string]bool, len(raw))
+	writeField := func(key string, value json.RawMessage) error {
+		if len(written) > 0 {
+			buf.WriteByte(',')
+		}
+		written[key] = true
+		keyBytes, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		buf.Write(keyBytes)
+		buf.WriteByte(':')
+		buf.Write(value)
+		return nil
+	}
+
+	for _, key := range order {
+		if value, ok := raw[key]; ok {
+			if err := writeField(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	remaining := make([]string, 0, len(raw)-len(written))
+	for key := range raw {
+		if !written[key] {
+			remaining = append(remaining, key)
+		}
+	}
+	sort.Strings(remaining)
+	for _, key := range remaining {
+		if err := writeField(key, raw[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func hasAllFields(raw map[string]json.RawMessage, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := raw[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// UnmarshalJSON decodes a header response without failing when the payload
+// doesn't match the fields HeaderImpl currently exposes. Previously, a node
+// upgrade that changed the header shape before a matching SDK release
+// existed would fail every decode outright; now it's reported through
+// Version and RawFields instead, so callers that don't need the missing
+// fields can keep going.
+func (h *HeaderImpl) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if !hasAllFields(raw, headerV0Fields) {
+		*h = HeaderImpl{Version: HeaderVersionUnknown, RawFields: raw}
+		return nil
+	}
+
+	// headerV0 has the same fields as HeaderImpl but none of its methods, so
+	// unmarshaling into it here doesn't recurse back into UnmarshalJSON.
+	type headerV0 HeaderImpl
+	var v0 headerV0
+	if err := json.Unmarshal(data, &v0); err != nil {
+		*h = HeaderImpl{Version: HeaderVersionUnknown, RawFields: raw}
+		return nil
+	}
+
+	*h = HeaderImpl(v0)
+	h.Version = HeaderVersionV0
+	h.RawFields = raw
+
+	if hasAllFields(raw, headerV3Fields) {
+		var epoch EpochHeaderFields
+		if err := json.Unmarshal(data, &epoch); err == nil {
+			h.Version = HeaderVersionV3
+			h.Epoch = &epoch
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON re-emits h in headerFieldOrder, so a header's commitment -
+// computed over its serialized form - matches whether it's computed over
+// the sequencer's own response or over this SDK's re-marshaled copy of it.
+//
+// When h.RawFields is populated (h came from UnmarshalJSON), each field is
+// written using its original raw bytes rather than re-serialized from h's
+// typed fields, so number formatting, string escaping, and any field this
+// SDK doesn't have a typed accessor for (builder_signature, fee_info) all
+// survive byte-for-byte. When h.RawFields is empty (h was built directly),
+// there's no original byte sequence to preserve, so this falls back to
+// marshaling h's own typed fields, still in headerFieldOrder.
+//
+// That byte-for-byte preservation is only guaranteed in the []byte this
+// method itself returns. encoding/json.Marshal always runs compact() over
+// whatever a MarshalJSON implementation returns, so json.Marshal(h) strips
+// any insignificant whitespace inside nested raw values (e.g. chain_config)
+// even though h.MarshalJSON() called directly would not have.
+func (h HeaderImpl) MarshalJSON() ([]byte, error) {
+	if len(h.RawFields) > 0 {
+		return marshalRawFieldsInOrder(h.RawFields, headerFieldOrder)
+	}
+
+	type orderedHeaderV0 struct {
+		ChainConfig       *ResolvableChainConfig `json:"chain_config,omitempty"`
+		Height            uint64                 `json:"height"`
+		Timestamp         uint64                 `json:"timestamp"`
+		L1Head            uint64                 `json:"l1_head"`
+		L1Finalized       *L1BlockInfo           `json:"l1_finalized,omitempty"`
+		PayloadCommitment string                 `json:"payload_commitment"`
+		BuilderCommitment string                 `json:"builder_commitment"`
+		NsTable           string                 `json:"ns_table"`
+		BlockMerkleRoot   string                 `json:"block_merkle_tree_root"`
+		FeeMerkleRoot     string                 `json:"fee_merkle_tree_root"`
+	}
+	return json.Marshal(orderedHeaderV0{
+		ChainConfig:       h.ChainConfig,
+		Height:            h.Height,
+		Timestamp:         h.Timestamp,
+		L1Head:            h.L1Head,
+		L1Finalized:       h.L1Finalized,
+		PayloadCommitment: h.PayloadCommitment,
+		BuilderCommitment: h.BuilderCommitment,
+		NsTable:           h.NsTable,
+		BlockMerkleRoot:   h.BlockMerkleRoot,
+		FeeMerkleRoot:     h.FeeMerkleRoot,
+	})
+}
+
+// MarshalJSONForJS encodes h the same way MarshalJSON does, except that
+// Height, Timestamp, and L1Head are JSON strings rather than numbers - see
+// Uint64String's doc comment for why a JS frontend needs that. Call this
+// explicitly instead of json.Marshal(h) at whichever call site's output
+// actually reaches JavaScript; every other consumer keeps getting plain
+// numbers from MarshalJSON.
+func (h HeaderImpl) MarshalJSONForJS() ([]byte, error) {
+	raw, err := h.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("types: decode header for js re-encoding: %w", err)
+	}
+	for _, key := range []string{"height", "timestamp", "l1_head"} {
+		v, ok := fields[key]
+		if !ok {
+			continue
+		}
+		var n uint64
+		if err := json.Unmarshal(v, &n); err != nil {
+			return nil, fmt.Errorf("types: header field %q is not a number: %w", key, err)
+		}
+		encoded, err := json.Marshal(Uint64String(n))
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = encoded
+	}
+
+	return marshalRawFieldsInOrder(fields, headerFieldOrder)
+}
+
+// MarshalCBOR encodes h's headerV0Fields as a canonical CBOR map, for
+// compact storage of headers in a rollup's database - see cbor.go's
+// package doc comment. ChainConfig, L1Finalized, and Epoch aren't included
+// yet, the same scope limit Header in types.proto takes for the same
+// reason: those fields are still evolving, and a fixed CBOR encoding of
+// them would need to change every time they did. MarshalCBOR fails if h
+// wasn't successfully decoded as at least HeaderVersionV0, since there's
+// nothing meaningful to encode otherwise.
+func (h HeaderImpl) MarshalCBOR() ([]byte, error) {
+	if h.Version != HeaderVersionV0 && h.Version != HeaderVersionV3 {
+		return nil, fmt.Errorf("types: cannot encode header with version %q as cbor", h.Version)
+	}
+	return EncodeCBORTextKeyedMap(map[string][]byte{
+		"height":                 EncodeCBORUint64(h.Height),
+		"timestamp":              EncodeCBORUint64(h.Timestamp),
+		"l1_head":                EncodeCBORUint64(h.L1Head),
+		"payload_commitment":     EncodeCBORText(h.PayloadCommitment),
+		"builder_commitment":     EncodeCBORText(h.BuilderCommitment),
+		"ns_table":               EncodeCBORText(h.NsTable),
+		"block_merkle_tree_root": EncodeCBORText(h.BlockMerkleRoot),
+		"fee_merkle_tree_root":   EncodeCBORText(h.FeeMerkleRoot),
+	}), nil
+}
+
+// UnmarshalCBOR decodes data produced by MarshalCBOR into h, setting
+// Version to HeaderVersionV0. RawFields, ChainConfig, L1Finalized, and
+// Epoch are left unset, since MarshalCBOR doesn't encode them; decode the
+// original JSON instead if those are needed.
+func (h *HeaderImpl) UnmarshalCBOR(data []byte) error {
+	fields, rest, err := DecodeCBORTextKeyedMap(data)
+	if err != nil {
+		return fmt.Errorf("types: decode cbor header: %w", err)
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("types: %d trailing bytes after cbor header", len(rest))
+	}
+
+	var decoded HeaderImpl
+	for key, fn := range map[string]func([]byte) error{
+		"height":                 func(b []byte) (err error) { decoded.Height, _, err = DecodeCBORUint64(b); return },
+		"timestamp":              func(b []byte) (err error) { decoded.Timestamp, _, err = DecodeCBORUint64(b); return },
+		"l1_head":                func(b []byte) (err error) { decoded.L1Head, _, err = DecodeCBORUint64(b); return },
+		"payload_commitment":     func(b []byte) (err error) { decoded.PayloadCommitment, _, err = DecodeCBORText(b); return },
+		"builder_commitment":     func(b []byte) (err error) { decoded.BuilderCommitment, _, err = DecodeCBORText(b); return },
+		"ns_table":               func(b []byte) (err error) { decoded.NsTable, _, err = DecodeCBORText(b); return },
+		"block_merkle_tree_root": func(b []byte) (err error) { decoded.BlockMerkleRoot, _, err = DecodeCBORText(b); return },
+		"fee_merkle_tree_root":   func(b []byte) (err error) { decoded.FeeMerkleRoot, _, err = DecodeCBORText(b); return },
+	} {
+		encoded, ok := fields[key]
+		if !ok {
+			return fmt.Errorf("types: cbor header missing field %q", key)
+		}
+		if err := fn(encoded); err != nil {
+			return fmt.Errorf("types: decode cbor header field %q: %w", key, err)
+		}
+	}
+
+	decoded.Version = HeaderVersionV0
+	*h = decoded
+	return nil
+}
+
+// Clone returns a deep copy of h. HeaderImpl's ChainConfig, L1Finalized,
+// Epoch, and RawFields are all either pointers or a map, so copying a
+// HeaderImpl by value still leaves the copy aliasing the original's
+// backing data; mutating one through those fields - e.g. adding a key to
+// RawFields - affects the other too. Clone breaks that aliasing so a
+// caller that hands out a HeaderImpl (a cache, a fan-out to multiple
+// goroutines) doesn't need every recipient to treat it as read-only by
+// convention.
+func (h HeaderImpl) Clone() HeaderImpl {
+	clone := h
+	if h.ChainConfig != nil {
+		cfg := *h.ChainConfig
+		if h.ChainConfig.Config != nil {
+			inner := *h.ChainConfig.Config
+			cfg.Config = &inner
+		}
+		clone.ChainConfig = &cfg
+	}
+	if h.L1Finalized != nil {
+		l1 := *h.L1Finalized
+		clone.L1Finalized = &l1
+	}
+	if h.Epoch != nil {
+		epoch := *h.Epoch
+		clone.Epoch = &epoch
+	}
+	if h.RawFields != nil {
+		clone.RawFields = make(map[string]json.RawMessage, len(h.RawFields))
+		for key, value := range h.RawFields {
+			raw := make(json.RawMessage, len(value))
+			copy(raw, value)
+			clone.RawFields[key] = raw
+		}
+	}
+	return clone
+}
+
+// Equal reports whether h and other represent the same header, comparing
+// ChainConfig, L1Finalized, Epoch, and RawFields by value rather than by
+// pointer or map identity.
+func (h HeaderImpl) Equal(other HeaderImpl) bool {
+	if h.Height != other.Height || h.Timestamp != other.Timestamp || h.L1Head != other.L1Head ||
+		h.PayloadCommitment != other.PayloadCommitment || h.BuilderCommitment != other.BuilderCommitment ||
+		h.NsTable != other.NsTable || h.BlockMerkleRoot != other.BlockMerkleRoot ||
+		h.FeeMerkleRoot != other.FeeMerkleRoot || h.Version != other.Version {
+		return false
+	}
+	if !resolvableChainConfigsEqual(h.ChainConfig, other.ChainConfig) {
+		return false
+	}
+	if (h.L1Finalized == nil) != (other.L1Finalized == nil) {
+		return false
+	}
+	if h.L1Finalized != nil && *h.L1Finalized != *other.L1Finalized {
+		return false
+	}
+	if (h.Epoch == nil) != (other.Epoch == nil) {
+		return false
+	}
+	if h.Epoch != nil && *h.Epoch != *other.Epoch {
+		return false
+	}
+	if len(h.RawFields) != len(other.RawFields) {
+		return false
+	}
+	for key, value := range h.RawFields {
+		otherValue, ok := other.RawFields[key]
+		if !ok || !bytes.Equal(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvableChainConfigsEqual compares a and b field-by-field rather than
+// with ==, since ResolvableChainConfig embeds pointers (Config, and the
+// FeeAmount pointers within it) that == would compare by identity instead
+// of value.
+func resolvableChainConfigsEqual(a, b *ResolvableChainConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.CommitmentOnly != b.CommitmentOnly {
+		return false
+	}
+	if (a.Config == nil) != (b.Config == nil) {
+		return false
+	}
+	if a.Config == nil {
+		return true
+	}
+	return feeAmountsEqual(a.Config.ChainID, b.Config.ChainID) &&
+		a.Config.MaxBlockSize == b.Config.MaxBlockSize &&
+		feeAmountsEqual(a.Config.BaseFee, b.Config.BaseFee)
+}
+
+// feeAmountsEqual compares a and b by value; FeeAmount's methods never
+// mutate a receiver in place (Add, Mul, and CheckedSub all return a new
+// value), so two FeeAmount pointers holding equal values are as good as
+// one for every purpose Equal cares about.
+func feeAmountsEqual(a, b *FeeAmount) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.BigInt().Cmp(b.BigInt()) == 0
+}
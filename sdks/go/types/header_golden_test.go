@@ -0,0 +1,144 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// "Golden" here means matching a fact verified against sequencer/src/header.rs:
+// Header's #[derive(Serialize)] has no field-level rename or
+// skip_serializing_if, so its fields serialize in the struct's declaration
+// order, and an Option field serializes as null rather than being omitted
+// when None. It is not a byte dump captured from a running node - none was
+// available in this environment - so ResolvableChainConfig's inner shape
+// (see chainconfig.go's doc comment) is this SDK's own inference, not
+// independently re-verified here.
+
+func TestHeaderImplMarshalJSONReordersScrambledInput(t *testing.T) {
+	// Deliberately out of canonical order and reformatted (extra spaces,
+	// keys shuffled) to prove MarshalJSON reorders regardless of how the
+	// input arrived, while still reusing each field's original bytes.
+	scrambled := []byte(`{
+		"fee_merkle_tree_root": "FEE~AAA",
+		"height":    5,
+		"l1_finalized": null,
+		"payload_commitment": "PAYLOAD~BBB",
+		"timestamp": 100,
+		"chain_config": {"chain_config": {"Right": "CHAIN-CONFIG~CCC"}},
+		"builder_commitment": "BUILDER~DDD",
+		"l1_head": 7,
+		"ns_table": "NSTABLE~EEE",
+		"block_merkle_tree_root": "BLOCK~FFF"
+	}`)
+
+	var h HeaderImpl
+	if err := json.Unmarshal(scrambled, &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if h.Version != HeaderVersionV0 {
+		t.Fatalf("got version %q, want %q", h.Version, HeaderVersionV0)
+	}
+
+	got, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	// Values are each field's exact raw bytes as captured by RawFields, but
+	// json.Marshal runs compact() over whatever MarshalJSON returns, so even
+	// the nested chain_config object's internal whitespace is stripped here -
+	// it would only survive a direct call to h.MarshalJSON() bypassing
+	// encoding/json's own Marshal.
+	want := `{"chain_config":{"chain_config":{"Right":"CHAIN-CONFIG~CCC"}},` +
+		`"height":5,` +
+		`"timestamp":100,` +
+		`"l1_head":7,` +
+		`"l1_finalized":null,` +
+		`"payload_commitment":"PAYLOAD~BBB",` +
+		`"builder_commitment":"BUILDER~DDD",` +
+		`"ns_table":"NSTABLE~EEE",` +
+		`"block_merkle_tree_root":"BLOCK~FFF",` +
+		`"fee_merkle_tree_root":"FEE~AAA"}`
+
+	if string(got) != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestHeaderImplMarshalJSONAppendsUnrecognizedFieldsSorted(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"timestamp": 100,
+		"l1_head": 7,
+		"payload_commitment": "p",
+		"builder_commitment": "b",
+		"ns_table": "n",
+		"block_merkle_tree_root": "r1",
+		"fee_merkle_tree_root": "r2",
+		"zzz_future_field": true,
+		"aaa_future_field": false
+	}`)
+	var h HeaderImpl
+	if err := json.Unmarshal(data, &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	got, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("re-decoding marshaled header: %v", err)
+	}
+	if string(decoded["aaa_future_field"]) != "false" || string(decoded["zzz_future_field"]) != "true" {
+		t.Fatalf("unrecognized fields not preserved: %s", got)
+	}
+
+	// The two unrecognized keys should appear after all known fields, sorted.
+	aaaIdx, zzzIdx := indexOfKey(got, "aaa_future_field"), indexOfKey(got, "zzz_future_field")
+	feeIdx := indexOfKey(got, "fee_merkle_tree_root")
+	if !(feeIdx < aaaIdx && aaaIdx < zzzIdx) {
+		t.Fatalf("unrecognized fields not in sorted order after known fields: %s", got)
+	}
+}
+
+func indexOfKey(data []byte, key string) int {
+	needle := `"` + key + `"`
+	for i := 0; i+len(needle) <= len(data); i++ {
+		if string(data[i:i+len(needle)]) == needle {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestHeaderImplMarshalJSONOrdersTypedFieldsWhenBuiltDirectly(t *testing.T) {
+	h := HeaderImpl{
+		Height:            5,
+		Timestamp:         100,
+		L1Head:            7,
+		PayloadCommitment: "p",
+		BuilderCommitment: "b",
+		NsTable:           "n",
+		BlockMerkleRoot:   "r1",
+		FeeMerkleRoot:     "r2",
+	}
+	got, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	heightIdx := indexOfKey(got, "height")
+	feeIdx := indexOfKey(got, "fee_merkle_tree_root")
+	if heightIdx < 0 || feeIdx < 0 || heightIdx > feeIdx {
+		t.Fatalf("fields not in headerFieldOrder: %s", got)
+	}
+	// chain_config and l1_finalized are nil, so they're omitted rather than
+	// emitted as null - there's no original RawFields byte sequence to
+	// preserve here, so this differs from the RawFields-backed path above.
+	if indexOfKey(got, "chain_config") != -1 || indexOfKey(got, "l1_finalized") != -1 {
+		t.Fatalf("expected nil ChainConfig/L1Finalized to be omitted: %s", got)
+	}
+}
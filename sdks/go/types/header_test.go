@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHeaderImplUnmarshalJSONRecognizesV0(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"timestamp": 100,
+		"l1_head": 7,
+		"payload_commitment": "p",
+		"builder_commitment": "b",
+		"ns_table": "n",
+		"block_merkle_tree_root": "r1",
+		"fee_merkle_tree_root": "r2"
+	}`)
+
+	var h HeaderImpl
+	if err := json.Unmarshal(data, &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if h.Version != HeaderVersionV0 {
+		t.Fatalf("got version %q, want %q", h.Version, HeaderVersionV0)
+	}
+	if h.Height != 5 || h.PayloadCommitment != "p" {
+		t.Fatalf("fields not populated: %+v", h)
+	}
+	if len(h.RawFields) != 8 {
+		t.Fatalf("got %d raw fields, want 8", len(h.RawFields))
+	}
+}
+
+func TestHeaderImplUnmarshalJSONFallsBackOnUnknownShape(t *testing.T) {
+	data := []byte(`{"height": 5, "new_field": "from-the-future"}`)
+
+	var h HeaderImpl
+	if err := json.Unmarshal(data, &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if h.Version != HeaderVersionUnknown {
+		t.Fatalf("got version %q, want %q", h.Version, HeaderVersionUnknown)
+	}
+	if h.Height != 0 {
+		t.Fatalf("got height %d, want 0 (not decoded into known fields)", h.Height)
+	}
+	if string(h.RawFields["new_field"]) != `"from-the-future"` {
+		t.Fatalf("RawFields missing new_field: %+v", h.RawFields)
+	}
+}
+
+func TestHeaderImplUnmarshalJSONRejectsInvalidJSON(t *testing.T) {
+	var h HeaderImpl
+	if err := json.Unmarshal([]byte("not json"), &h); err == nil {
+		t.Fatal("got nil error, want a JSON syntax error")
+	}
+}
+
+func TestHeaderImplCloneIsIndependent(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"timestamp": 100,
+		"l1_head": 7,
+		"payload_commitment": "p",
+		"builder_commitment": "b",
+		"ns_table": "n",
+		"block_merkle_tree_root": "r1",
+		"fee_merkle_tree_root": "r2",
+		"l1_finalized": {"number": 1, "timestamp": "0x1", "hash": "0xabc"}
+	}`)
+	var h HeaderImpl
+	if err := json.Unmarshal(data, &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	clone := h.Clone()
+	clone.RawFields["height"] = json.RawMessage("999")
+	clone.L1Finalized.Number = 999
+
+	if string(h.RawFields["height"]) != "5" {
+		t.Fatalf("mutating the clone's RawFields changed the original: %s", h.RawFields["height"])
+	}
+	if h.L1Finalized.Number != 1 {
+		t.Fatalf("mutating the clone's L1Finalized changed the original: %+v", h.L1Finalized)
+	}
+
+	var want HeaderImpl
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !h.Equal(want) {
+		t.Fatalf("original should still equal a freshly decoded copy of the same JSON")
+	}
+	if h.Equal(clone) {
+		t.Fatalf("mutated clone should no longer equal the original")
+	}
+}
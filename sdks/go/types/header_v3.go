@@ -0,0 +1,46 @@
+package types
+
+// EpochHeaderFields are the additional fields a HeaderVersionV3 header
+// carries on top of the HeaderVersionV0 fields: the current epoch number and
+// commitments to the epoch's stake table and accumulated rewards.
+//
+// The sequencer's epoch/stake-rotation design isn't part of the Rust source
+// vendored in this tree (this snapshot's ChainConfig and Header predate it),
+// so these field names and JSON keys are this SDK's own forward-looking
+// projection, not a port of a real Rust struct. Treat them as provisional
+// until checked against the sequencer's header.rs once it defines epoch
+// fields, and against real captured headers once a v3 node is reachable —
+// neither was available in this environment.
+type EpochHeaderFields struct {
+	Epoch                uint64 `json:"epoch"`
+	StakeTableCommitment string `json:"stake_table_commitment"`
+	RewardMerkleRoot     string `json:"reward_merkle_tree_root"`
+}
+
+// Header is implemented by HeaderImpl. It exists so callers that only need
+// the version tag or the epoch-era fields can depend on an interface instead
+// of reaching into HeaderImpl's fields directly, and so a second header
+// representation could implement it later without changing those callers.
+type Header interface {
+	// HeaderVersion reports which shape this header decoded as.
+	HeaderVersion() HeaderVersion
+	// EpochFields returns the epoch, stake-table, and reward fields added in
+	// HeaderVersionV3, and false if this header predates them.
+	EpochFields() (EpochHeaderFields, bool)
+}
+
+var _ Header = HeaderImpl{}
+
+// HeaderVersion reports which shape UnmarshalJSON decoded h as.
+func (h HeaderImpl) HeaderVersion() HeaderVersion {
+	return h.Version
+}
+
+// EpochFields returns h's epoch-era fields, and false if h isn't
+// HeaderVersionV3.
+func (h HeaderImpl) EpochFields() (EpochHeaderFields, bool) {
+	if h.Epoch == nil {
+		return EpochHeaderFields{}, false
+	}
+	return *h.Epoch, true
+}
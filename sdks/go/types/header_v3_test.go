@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// v3HeaderFixture is a synthetic header matching the HeaderVersionV3 shape.
+// No captured mainnet v3 header was available in this environment (the v3
+// epoch fields aren't part of this tree's vendored Rust source at all; see
+// EpochHeaderFields's doc comment), so this fixture is hand-built instead of
+// captured.
+const v3HeaderFixture = `{
+	"height": 1000,
+	"timestamp": 1700000000,
+	"l1_head": 42,
+	"payload_commitment": "p",
+	"builder_commitment": "b",
+	"ns_table": "n",
+	"block_merkle_tree_root": "bmt",
+	"fee_merkle_tree_root": "fmt",
+	"epoch": 3,
+	"stake_table_commitment": "stc",
+	"reward_merkle_tree_root": "rmt"
+}`
+
+func TestHeaderImplUnmarshalJSONRecognizesV3(t *testing.T) {
+	var h HeaderImpl
+	if err := json.Unmarshal([]byte(v3HeaderFixture), &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if h.HeaderVersion() != HeaderVersionV3 {
+		t.Fatalf("got version %q, want %q", h.HeaderVersion(), HeaderVersionV3)
+	}
+	if h.Height != 1000 {
+		t.Fatalf("got height %d, want 1000", h.Height)
+	}
+
+	epoch, ok := h.EpochFields()
+	if !ok {
+		t.Fatal("EpochFields: got ok=false, want true")
+	}
+	want := EpochHeaderFields{Epoch: 3, StakeTableCommitment: "stc", RewardMerkleRoot: "rmt"}
+	if epoch != want {
+		t.Fatalf("got %+v, want %+v", epoch, want)
+	}
+}
+
+func TestHeaderImplEpochFieldsFalseForV0(t *testing.T) {
+	data := []byte(`{
+		"height": 1, "timestamp": 1, "l1_head": 1,
+		"payload_commitment": "p", "builder_commitment": "b", "ns_table": "n",
+		"block_merkle_tree_root": "bmt", "fee_merkle_tree_root": "fmt"
+	}`)
+
+	var h HeaderImpl
+	if err := json.Unmarshal(data, &h); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if _, ok := h.EpochFields(); ok {
+		t.Fatal("EpochFields: got ok=true for a v0 header, want false")
+	}
+}
+
+func TestHeaderInterfaceIsSatisfiedByHeaderImpl(t *testing.T) {
+	var _ Header = HeaderImpl{}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Uint64String is a uint64 that marshals as a JSON string instead of a
+// number. JavaScript's Number type is an IEEE-754 double, which can only
+// represent integers exactly up to 2^53-1; a height, timestamp, or
+// namespace ID above that silently loses precision once it round-trips
+// through a JS frontend's JSON.parse. Encoding it as a string sidesteps
+// that at the cost of the field no longer being a plain number in
+// JavaScript either - see the *ForJS methods below for where this gets
+// used, opt-in per call rather than as a global encoding mode.
+type Uint64String uint64
+
+// MarshalJSON encodes u as a JSON string, e.g. Uint64String(5) -> "5".
+func (u Uint64String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(u), 10))
+}
+
+// UnmarshalJSON decodes a JSON string produced by MarshalJSON, or a bare
+// JSON number, into u - accepting both means a Uint64String field can
+// decode either this SDK's own *ForJS output or an ordinary numeric
+// response, without the caller needing to know in advance which it got.
+func (u *Uint64String) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("types: invalid uint64 string %q: %w", s, err)
+		}
+		*u = Uint64String(v)
+		return nil
+	}
+
+	var v uint64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("types: invalid uint64 %s: %w", data, err)
+	}
+	*u = Uint64String(v)
+	return nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUint64StringMarshalsAsString(t *testing.T) {
+	got, err := json.Marshal(Uint64String(18446744073709551615))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `"18446744073709551615"`
+	if string(got) != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestUint64StringUnmarshalsStringOrNumber(t *testing.T) {
+	var fromString Uint64String
+	if err := json.Unmarshal([]byte(`"42"`), &fromString); err != nil {
+		t.Fatalf("Unmarshal string: %v", err)
+	}
+	if fromString != 42 {
+		t.Fatalf("got %d, want 42", fromString)
+	}
+
+	var fromNumber Uint64String
+	if err := json.Unmarshal([]byte(`42`), &fromNumber); err != nil {
+		t.Fatalf("Unmarshal number: %v", err)
+	}
+	if fromNumber != 42 {
+		t.Fatalf("got %d, want 42", fromNumber)
+	}
+}
+
+func TestUint64StringUnmarshalRejectsGarbage(t *testing.T) {
+	var u Uint64String
+	if err := json.Unmarshal([]byte(`"not-a-number"`), &u); err == nil {
+		t.Fatal("expected an error for a non-numeric string")
+	}
+}
+
+func TestTransactionMarshalJSONForJSEncodesNamespaceAsString(t *testing.T) {
+	tx := Transaction{Namespace: 42, Payload: []byte("hi")}
+	got, err := tx.MarshalJSONForJS()
+	if err != nil {
+		t.Fatalf("MarshalJSONForJS: %v", err)
+	}
+
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("re-decoding: %v", err)
+	}
+	if string(decoded["namespace"]) != `"42"` {
+		t.Fatalf("got namespace %s, want %q", decoded["namespace"], `"42"`)
+	}
+}
+
+func TestNamespaceProofMarshalJSONForJSEncodesNestedNamespaces(t *testing.T) {
+	p := NamespaceProof{
+		Namespace:    7,
+		Proof:        []byte("proof"),
+		Transactions: []Transaction{{Namespace: 7, Payload: []byte("tx1")}},
+	}
+	got, err := p.MarshalJSONForJS()
+	if err != nil {
+		t.Fatalf("MarshalJSONForJS: %v", err)
+	}
+
+	var decoded struct {
+		Namespace    string            `json:"namespace"`
+		Transactions []json.RawMessage `json:"transactions"`
+	}
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("re-decoding: %v", err)
+	}
+	if decoded.Namespace != "7" {
+		t.Fatalf("got namespace %q, want \"7\"", decoded.Namespace)
+	}
+	if len(decoded.Transactions) != 1 {
+		t.Fatalf("got %d transactions, want 1", len(decoded.Transactions))
+	}
+
+	var tx map[string]json.RawMessage
+	if err := json.Unmarshal(decoded.Transactions[0], &tx); err != nil {
+		t.Fatalf("re-decoding transaction: %v", err)
+	}
+	if string(tx["namespace"]) != `"7"` {
+		t.Fatalf("got transaction namespace %s, want %q", tx["namespace"], `"7"`)
+	}
+}
+
+func TestHeaderImplMarshalJSONForJSEncodesUint64FieldsAsStrings(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"timestamp": 100,
+		"l1_head": 7,
+		"payload_commitment": "p",
+		"builder_commitment": "b",
+		"ns_table": "n",
+		"block_merkle_tree_root": "r1",
+		"fee_merkle_tree_root": "r2"
+	}`)
+	var h HeaderImpl
+	if err := h.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	got, err := h.MarshalJSONForJS()
+	if err != nil {
+		t.Fatalf("MarshalJSONForJS: %v", err)
+	}
+
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("re-decoding: %v", err)
+	}
+	if string(decoded["height"]) != `"5"` {
+		t.Fatalf("got height %s, want %q", decoded["height"], `"5"`)
+	}
+	if string(decoded["timestamp"]) != `"100"` {
+		t.Fatalf("got timestamp %s, want %q", decoded["timestamp"], `"100"`)
+	}
+	if string(decoded["l1_head"]) != `"7"` {
+		t.Fatalf("got l1_head %s, want %q", decoded["l1_head"], `"7"`)
+	}
+	// Non-numeric fields are untouched.
+	if string(decoded["payload_commitment"]) != `"p"` {
+		t.Fatalf("got payload_commitment %s, want %q", decoded["payload_commitment"], `"p"`)
+	}
+}
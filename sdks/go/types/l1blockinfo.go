@@ -0,0 +1,18 @@
+package types
+
+// L1BlockInfo identifies a single L1 block, either the current L1 head
+// (HeaderImpl.L1Head, just a block number with no timestamp or hash, since
+// the head is subject to reorgs) or the latest L1 finalized block at the
+// time the header was sequenced (HeaderImpl.L1Finalized). See l1_head's doc
+// comment on HeaderImpl for why the two are represented differently.
+type L1BlockInfo struct {
+	Number uint64 `json:"number"`
+	// Timestamp is the L1 block's Unix timestamp. The Rust type encodes
+	// this as an ethers U256 rather than a u64; ethers-rs isn't vendored in
+	// this tree to check its exact JSON encoding against, so this SDK keeps
+	// it as the raw string the node returns instead of guessing whether
+	// that's decimal or "0x"-prefixed hex.
+	Timestamp string `json:"timestamp"`
+	// Hash is the L1 block's hash, a "0x"-prefixed hex string.
+	Hash string `json:"hash"`
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestL1BlockInfoUnmarshalJSON(t *testing.T) {
+	data := []byte(`{"number": 42, "timestamp": "1700000000", "hash": "0xabc"}`)
+
+	var info L1BlockInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if info.Number != 42 {
+		t.Fatalf("got number %d, want 42", info.Number)
+	}
+	if info.Timestamp != "1700000000" {
+		t.Fatalf("got timestamp %q, want 1700000000", info.Timestamp)
+	}
+	if info.Hash != "0xabc" {
+		t.Fatalf("got hash %q, want 0xabc", info.Hash)
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+// LightClientState mirrors the light client contract's on-chain state
+// struct (contract-bindings' LightClientState): the fields a HotShot state
+// update commits to. The view number and block height are plain uint64s;
+// the commitments and threshold are decimal-string field elements rather
+// than ethers' U256 since this SDK has no big-integer dependency of its
+// own. Field names follow the contract's, not the Rust state machine's, so
+// a value read off-chain and one built from a query service response share
+// one shape.
+type LightClientState struct {
+	ViewNumber               uint64 `json:"view_num"`
+	BlockHeight              uint64 `json:"block_height"`
+	BlockCommRoot            string `json:"block_comm_root"`
+	FeeLedgerComm            string `json:"fee_ledger_comm"`
+	StakeTableBLSKeyComm     string `json:"stake_table_bls_key_comm"`
+	StakeTableSchnorrKeyComm string `json:"stake_table_schnorr_key_comm"`
+	StakeTableAmountComm     string `json:"stake_table_amount_comm"`
+	Threshold                string `json:"threshold"`
+}
+
+// StateSignature is one stake table member's BLS signature over a
+// LightClientState, as relayed by the state relay server.
+type StateSignature struct {
+	Key       string `json:"key"`
+	Signature string `json:"signature"`
+}
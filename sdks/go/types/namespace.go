@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// NamespaceId identifies a rollup's namespace within the sequencer. It is a
+// uint64 newtype rather than a bare uint64 so namespace IDs can't be
+// confused with heights, indices, or other uint64-typed fields at compile
+// time, and so this package can validate and JSON-decode them in one
+// place instead of every caller re-deriving the same range check.
+//
+// The wire encoding of a namespace ID is a 32-bit unsigned integer - see
+// nsTableEntrySize's doc comment - so a NamespaceId's valid range is
+// [0, 2^32), even though the Go type is 64 bits wide.
+type NamespaceId uint64
+
+// SystemNamespace is reserved for the sequencer's own internal use and is
+// never assigned to a rollup. IsSystem reports whether a NamespaceId is
+// this reserved value.
+const SystemNamespace NamespaceId = 0
+
+// ErrNamespaceIDOutOfRange is returned when a NamespaceId doesn't fit in
+// the wire format's 32-bit range.
+var ErrNamespaceIDOutOfRange = errors.New("types: namespace id exceeds the 32-bit wire format range")
+
+// Valid reports whether n fits in the wire format's 32-bit range.
+func (n NamespaceId) Valid() bool {
+	return n <= math.MaxUint32
+}
+
+// IsSystem reports whether n is the reserved SystemNamespace.
+func (n NamespaceId) IsSystem() bool {
+	return n == SystemNamespace
+}
+
+// ParseNamespaceId validates v and returns it as a NamespaceId.
+func ParseNamespaceId(v uint64) (NamespaceId, error) {
+	n := NamespaceId(v)
+	if !n.Valid() {
+		return 0, fmt.Errorf("%w: %d", ErrNamespaceIDOutOfRange, v)
+	}
+	return n, nil
+}
+
+// String returns n's decimal representation, e.g. for logging.
+func (n NamespaceId) String() string {
+	return strconv.FormatUint(uint64(n), 10)
+}
+
+// MarshalText implements encoding.TextMarshaler, so a NamespaceId round-trips
+// through config files and CLI flags the same way types.Commitment does; see
+// its MarshalText doc comment for why this is needed alongside JSON.
+func (n NamespaceId) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, rejecting a value
+// outside the wire format's 32-bit range the same way UnmarshalJSON does.
+func (n *NamespaceId) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return fmt.Errorf("types: invalid namespace id %q: %w", text, err)
+	}
+	id, err := ParseNamespaceId(v)
+	if err != nil {
+		return err
+	}
+	*n = id
+	return nil
+}
+
+// MarshalJSON encodes n as a plain JSON number, the same wire shape a bare
+// uint64 field would produce.
+func (n NamespaceId) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint64(n))
+}
+
+// UnmarshalJSON decodes a JSON number into n, rejecting one outside the
+// wire format's 32-bit range.
+func (n *NamespaceId) UnmarshalJSON(data []byte) error {
+	var v uint64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	id, err := ParseNamespaceId(v)
+	if err != nil {
+		return err
+	}
+	*n = id
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseNamespaceIdRejectsOutOfRange(t *testing.T) {
+	_, err := ParseNamespaceId(1 << 33)
+	if !errors.Is(err, ErrNamespaceIDOutOfRange) {
+		t.Fatalf("got %v, want ErrNamespaceIDOutOfRange", err)
+	}
+}
+
+func TestParseNamespaceIdAcceptsInRange(t *testing.T) {
+	n, err := ParseNamespaceId(42)
+	if err != nil {
+		t.Fatalf("ParseNamespaceId: %v", err)
+	}
+	if n != 42 {
+		t.Fatalf("got %d, want 42", n)
+	}
+}
+
+func TestNamespaceIdJSONRoundTrips(t *testing.T) {
+	data, err := json.Marshal(NamespaceId(42))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != "42" {
+		t.Fatalf("got %s, want 42", data)
+	}
+
+	var n NamespaceId
+	if err := json.Unmarshal(data, &n); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if n != 42 {
+		t.Fatalf("got %d, want 42", n)
+	}
+}
+
+func TestNamespaceIdUnmarshalRejectsOutOfRange(t *testing.T) {
+	var n NamespaceId
+	err := json.Unmarshal([]byte("18446744073709551615"), &n)
+	if !errors.Is(err, ErrNamespaceIDOutOfRange) {
+		t.Fatalf("got %v, want ErrNamespaceIDOutOfRange", err)
+	}
+}
+
+func TestNamespaceIdTextRoundTrips(t *testing.T) {
+	n := NamespaceId(42)
+	text, err := n.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	if string(text) != "42" {
+		t.Fatalf("got %s, want 42", text)
+	}
+
+	var decoded NamespaceId
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if decoded != n {
+		t.Fatalf("got %d, want %d", decoded, n)
+	}
+}
+
+func TestNamespaceIdUnmarshalTextRejectsOutOfRange(t *testing.T) {
+	var n NamespaceId
+	err := n.UnmarshalText([]byte("18446744073709551615"))
+	if !errors.Is(err, ErrNamespaceIDOutOfRange) {
+		t.Fatalf("got %v, want ErrNamespaceIDOutOfRange", err)
+	}
+}
+
+func TestSystemNamespaceIsSystem(t *testing.T) {
+	if !SystemNamespace.IsSystem() {
+		t.Fatal("SystemNamespace.IsSystem() = false, want true")
+	}
+	if NamespaceId(1).IsSystem() {
+		t.Fatal("NamespaceId(1).IsSystem() = true, want false")
+	}
+}
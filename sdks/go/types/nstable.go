@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// nsTableEntrySize is the encoded size, in bytes, of one namespace table
+// entry: a little-endian u32 namespace ID followed by a little-endian u32
+// cumulative end offset.
+const nsTableEntrySize = 8
+
+// NsTableEntry is one namespace's range within a block's payload: the
+// payload bytes belonging to Namespace run from Offset to Offset+Length.
+type NsTableEntry struct {
+	Namespace NamespaceId
+	Offset    uint32
+	Length    uint32
+}
+
+// NsTable is a block's decoded namespace table: an ordered list of the
+// namespaces present in the block's payload and where each one's bytes
+// live, so a rollup can tell whether its namespace is even in a block
+// before paying for a namespace proof fetch.
+type NsTable struct {
+	Entries []NsTableEntry
+}
+
+// ParseNsTable decodes raw, the little-endian binary encoding the
+// sequencer uses for a block header's ns_table field. header.NsTable is
+// base64 text as returned by the query service; callers must
+// base64-decode it themselves before calling ParseNsTable, the same way
+// other byte-exact fields in this package are handled.
+//
+// The encoding is a u32 entry count, followed by that many (namespace ID,
+// cumulative end offset) pairs, each a pair of little-endian u32s. An
+// entry's offset is the end of its own range within the payload; its
+// start is the previous entry's offset, or 0 for the first entry.
+func ParseNsTable(raw []byte) (NsTable, error) {
+	if len(raw) < 4 {
+		return NsTable{}, fmt.Errorf("types: ns_table is %d bytes, too short for an entry count", len(raw))
+	}
+	count := binary.LittleEndian.Uint32(raw[:4])
+	want := 4 + int(count)*nsTableEntrySize
+	if len(raw) != want {
+		return NsTable{}, fmt.Errorf("types: ns_table declares %d entries, expected %d bytes, got %d", count, want, len(raw))
+	}
+
+	entries := make([]NsTableEntry, count)
+	var start uint32
+	for i := range entries {
+		base := 4 + i*nsTableEntrySize
+		namespace := binary.LittleEndian.Uint32(raw[base : base+4])
+		offset := binary.LittleEndian.Uint32(raw[base+4 : base+8])
+		if offset < start {
+			return NsTable{}, fmt.Errorf("types: ns_table entry %d offset %d precedes preceding entry's offset %d", i, offset, start)
+		}
+		entries[i] = NsTableEntry{
+			Namespace: NamespaceId(namespace),
+			Offset:    start,
+			Length:    offset - start,
+		}
+		start = offset
+	}
+	return NsTable{Entries: entries}, nil
+}
+
+// Contains reports whether namespace has an entry in the table.
+func (t NsTable) Contains(namespace NamespaceId) bool {
+	_, ok := t.Lookup(namespace)
+	return ok
+}
+
+// Lookup returns namespace's entry, if present.
+func (t NsTable) Lookup(namespace NamespaceId) (NsTableEntry, bool) {
+	for _, e := range t.Entries {
+		if e.Namespace == namespace {
+			return e, true
+		}
+	}
+	return NsTableEntry{}, false
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func encodeNsTable(t *testing.T, pairs [][2]uint32) []byte {
+	t.Helper()
+	buf := make([]byte, 4+len(pairs)*nsTableEntrySize)
+	binary.LittleEndian.PutUint32(buf[:4], uint32(len(pairs)))
+	for i, p := range pairs {
+		base := 4 + i*nsTableEntrySize
+		binary.LittleEndian.PutUint32(buf[base:base+4], p[0])
+		binary.LittleEndian.PutUint32(buf[base+4:base+8], p[1])
+	}
+	return buf
+}
+
+func TestParseNsTableComputesOffsetsAndLengths(t *testing.T) {
+	raw := encodeNsTable(t, [][2]uint32{{1, 10}, {2, 25}})
+
+	table, err := ParseNsTable(raw)
+	if err != nil {
+		t.Fatalf("ParseNsTable: %v", err)
+	}
+	want := []NsTableEntry{
+		{Namespace: 1, Offset: 0, Length: 10},
+		{Namespace: 2, Offset: 10, Length: 15},
+	}
+	if len(table.Entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(table.Entries), len(want))
+	}
+	for i, e := range table.Entries {
+		if e != want[i] {
+			t.Fatalf("entry %d: got %+v, want %+v", i, e, want[i])
+		}
+	}
+}
+
+func TestParseNsTableRejectsTruncatedInput(t *testing.T) {
+	raw := encodeNsTable(t, [][2]uint32{{1, 10}})
+
+	if _, err := ParseNsTable(raw[:len(raw)-1]); err == nil {
+		t.Fatal("expected error for truncated ns_table")
+	}
+}
+
+func TestNsTableLookupAndContains(t *testing.T) {
+	raw := encodeNsTable(t, [][2]uint32{{1, 10}, {2, 25}})
+	table, err := ParseNsTable(raw)
+	if err != nil {
+		t.Fatalf("ParseNsTable: %v", err)
+	}
+
+	if !table.Contains(2) {
+		t.Fatal("expected table to contain namespace 2")
+	}
+	if table.Contains(3) {
+		t.Fatal("did not expect table to contain namespace 3")
+	}
+
+	entry, ok := table.Lookup(1)
+	if !ok || entry.Offset != 0 || entry.Length != 10 {
+		t.Fatalf("got %+v, %v, want offset 0 length 10", entry, ok)
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrNamespaceNotInTable is returned by ExtractNamespaceTransactions when
+// the requested namespace has no entry in the namespace table, i.e. the
+// block's payload carries no transactions for it.
+var ErrNamespaceNotInTable = errors.New("types: namespace not present in ns_table")
+
+// ExtractNamespaceTransactions decodes namespace's transactions directly
+// out of payload using table, without a namespace proof. This is the
+// pure-Go, non-cryptographic counterpart to verification.VerifyNamespace:
+// it trusts that payload and table genuinely correspond to each other
+// (e.g. both just came from the same query service response), so
+// consumers can read optimistically and fall back to
+// verification.VerifyNamespace only when they need proof that the pairing
+// is genuine.
+//
+// payload must be the full, undecoded block payload table was parsed
+// against; ParseNsTable's offsets are byte offsets into it.
+func ExtractNamespaceTransactions(payload []byte, table NsTable, namespace NamespaceId) ([]Transaction, error) {
+	entry, ok := table.Lookup(namespace)
+	if !ok {
+		return nil, ErrNamespaceNotInTable
+	}
+	end := uint64(entry.Offset) + uint64(entry.Length)
+	if end > uint64(len(payload)) {
+		return nil, fmt.Errorf("types: namespace %d range [%d, %d) exceeds payload length %d", namespace, entry.Offset, end, len(payload))
+	}
+	segment := payload[entry.Offset:end]
+
+	if len(segment) < 4 {
+		return nil, fmt.Errorf("types: namespace %d segment is %d bytes, too short for a transaction count", namespace, len(segment))
+	}
+	numTxs := binary.LittleEndian.Uint32(segment[:4])
+	offsetsEnd := 4 + int(numTxs)*4
+	if len(segment) < offsetsEnd {
+		return nil, fmt.Errorf("types: namespace %d declares %d transactions, too short for their offsets", namespace, numTxs)
+	}
+
+	txs := make([]Transaction, numTxs)
+	var start uint32
+	for i := range txs {
+		base := 4 + i*4
+		txEnd := binary.LittleEndian.Uint32(segment[base : base+4])
+		if txEnd < start || offsetsEnd+int(txEnd) > len(segment) {
+			return nil, fmt.Errorf("types: namespace %d transaction %d offset %d is out of range", namespace, i, txEnd)
+		}
+		txs[i] = Transaction{
+			Namespace: namespace,
+			Payload:   segment[offsetsEnd+int(start) : offsetsEnd+int(txEnd)],
+		}
+		start = txEnd
+	}
+	return txs, nil
+}
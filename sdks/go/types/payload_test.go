@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func encodeNsSegment(t *testing.T, txs [][]byte) []byte {
+	t.Helper()
+	offsetsLen := 4 + len(txs)*4
+	var txBytes []byte
+	offsets := make([]uint32, len(txs))
+	var cum uint32
+	for i, tx := range txs {
+		cum += uint32(len(tx))
+		offsets[i] = cum
+		txBytes = append(txBytes, tx...)
+	}
+	buf := make([]byte, offsetsLen+len(txBytes))
+	binary.LittleEndian.PutUint32(buf[:4], uint32(len(txs)))
+	for i, off := range offsets {
+		base := 4 + i*4
+		binary.LittleEndian.PutUint32(buf[base:base+4], off)
+	}
+	copy(buf[offsetsLen:], txBytes)
+	return buf
+}
+
+func TestExtractNamespaceTransactionsDecodesSegment(t *testing.T) {
+	segment := encodeNsSegment(t, [][]byte{[]byte("hello"), []byte("world!")})
+	table := NsTable{Entries: []NsTableEntry{{Namespace: 7, Offset: 0, Length: uint32(len(segment))}}}
+
+	txs, err := ExtractNamespaceTransactions(segment, table, 7)
+	if err != nil {
+		t.Fatalf("ExtractNamespaceTransactions: %v", err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("got %d transactions, want 2", len(txs))
+	}
+	if string(txs[0].Payload) != "hello" || string(txs[1].Payload) != "world!" {
+		t.Fatalf("got %q, %q", txs[0].Payload, txs[1].Payload)
+	}
+	if txs[0].Namespace != 7 || txs[1].Namespace != 7 {
+		t.Fatalf("expected namespace 7 on both transactions, got %+v", txs)
+	}
+}
+
+func TestExtractNamespaceTransactionsRejectsMissingNamespace(t *testing.T) {
+	table := NsTable{Entries: []NsTableEntry{{Namespace: 1, Offset: 0, Length: 0}}}
+
+	if _, err := ExtractNamespaceTransactions(nil, table, 2); err != ErrNamespaceNotInTable {
+		t.Fatalf("got %v, want ErrNamespaceNotInTable", err)
+	}
+}
+
+func TestExtractNamespaceTransactionsRejectsOutOfRangeEntry(t *testing.T) {
+	table := NsTable{Entries: []NsTableEntry{{Namespace: 1, Offset: 0, Length: 100}}}
+
+	if _, err := ExtractNamespaceTransactions([]byte("short"), table, 1); err == nil {
+		t.Fatal("expected error for out-of-range namespace entry")
+	}
+}
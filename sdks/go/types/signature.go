@@ -0,0 +1,35 @@
+package types
+
+// AggregateSignature is the aggregated BLS signature a HotShot quorum
+// certificate carries over its leaf commitment (see QuorumCertificate),
+// exactly as the query service's leaf endpoint returns it.
+//
+// It's a byte-preserving wrapper around that raw value, not a decoded
+// signature: HotShot's stake-table and BLS-aggregation crates, which
+// define the actual encoding (curve point plus per-signer participation,
+// if the scheme used elsewhere in HotShot is any guide), aren't vendored
+// in this tree, so this SDK can't parse one into its components without
+// guessing at a format it hasn't verified against real source. String,
+// MarshalText, and UnmarshalText are all this type offers; a caller that
+// needs to check the signature itself should hand this value, unparsed,
+// to a system that does have those crates.
+type AggregateSignature string
+
+// String returns s's wire representation, e.g. for logging.
+func (s AggregateSignature) String() string {
+	return string(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, so an AggregateSignature
+// round-trips through config files and CLI flags the same way Commitment
+// does (see its MarshalText for why: encoding/json already marshals it as
+// a plain string via its underlying type, but other formats need this).
+func (s AggregateSignature) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler; see MarshalText.
+func (s *AggregateSignature) UnmarshalText(text []byte) error {
+	*s = AggregateSignature(text)
+	return nil
+}
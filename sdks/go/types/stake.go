@@ -0,0 +1,8 @@
+package types
+
+// StakeTableEntry is a single validator's entry in the HotShot stake table.
+type StakeTableEntry struct {
+	BLSPubKey   string `json:"stake_key"`
+	StateVerKey string `json:"state_ver_key"`
+	Stake       string `json:"stake_amount"`
+}
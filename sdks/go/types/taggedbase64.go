@@ -0,0 +1,114 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// tagPattern matches the tag portion of a tagged-base64 string: the Rust
+// `tagged-base64` crate restricts it to ASCII letters and digits so it can
+// never collide with the "~" separator or need escaping.
+var tagPattern = regexp.MustCompile(`^[a-zA-Z0-9]*$`)
+
+const tagSeparator = "~"
+
+var (
+	// ErrInvalidTag is returned when a tag contains characters other than
+	// ASCII letters and digits.
+	ErrInvalidTag = errors.New("taggedbase64: tag must be alphanumeric")
+	// ErrInvalidFormat is returned when a string has no "~" separator.
+	ErrInvalidFormat = errors.New("taggedbase64: missing '~' separator")
+	// ErrInvalidEncoding is returned when the payload after "~" isn't
+	// valid unpadded URL-safe base64, or is too short to hold a checksum
+	// byte.
+	ErrInvalidEncoding = errors.New("taggedbase64: malformed base64 payload")
+	// ErrChecksumMismatch is returned by DecodeTaggedBase64 when the
+	// trailing checksum byte doesn't match the tag and value.
+	ErrChecksumMismatch = errors.New("taggedbase64: checksum mismatch")
+)
+
+// EncodeTaggedBase64 encodes value under tag in the tagged-base64 format
+// used throughout the sequencer's APIs: "tag~base64url(value||checksum)".
+// tag must be alphanumeric.
+//
+// The checksum byte is this SDK's own - a truncated SHA-256 of the tag and
+// value, not the algorithm the Rust `tagged-base64` crate uses internally,
+// which isn't vendored here. Values EncodeTaggedBase64 produces round-trip
+// through DecodeTaggedBase64 correctly; to read a tagged-base64 string the
+// node emitted, use ParseTaggedBase64 instead, which doesn't require the
+// checksums to agree.
+func EncodeTaggedBase64(tag string, value []byte) (string, error) {
+	if !tagPattern.MatchString(tag) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+	}
+	payload := make([]byte, len(value)+1)
+	copy(payload, value)
+	payload[len(value)] = taggedBase64Checksum(tag, value)
+	return tag + tagSeparator + base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+// DecodeTaggedBase64 decodes a tagged-base64 string produced by
+// EncodeTaggedBase64, verifying its checksum byte. Use ParseTaggedBase64
+// instead for strings the sequencer node emitted; see EncodeTaggedBase64's
+// doc comment for why the checksums don't agree across implementations.
+func DecodeTaggedBase64(s string) (tag string, value []byte, err error) {
+	tag, decoded, err := splitTaggedBase64(s)
+	if err != nil {
+		return "", nil, err
+	}
+	value, sum := decoded[:len(decoded)-1], decoded[len(decoded)-1]
+	if taggedBase64Checksum(tag, value) != sum {
+		return "", nil, ErrChecksumMismatch
+	}
+	return tag, value, nil
+}
+
+// ParseTaggedBase64 decodes a tagged-base64 string into its tag and value
+// without verifying the trailing checksum byte, since this SDK doesn't
+// vendor the Rust `tagged-base64` crate's checksum algorithm and so can't
+// reproduce it for values the crate encoded. It still validates the tag's
+// character set and that the payload is well-formed base64 long enough to
+// hold a checksum byte, which is what callers stripping tags by hand were
+// getting wrong.
+func ParseTaggedBase64(s string) (tag string, value []byte, err error) {
+	tag, decoded, err := splitTaggedBase64(s)
+	if err != nil {
+		return "", nil, err
+	}
+	return tag, decoded[:len(decoded)-1], nil
+}
+
+func splitTaggedBase64(s string) (tag string, decoded []byte, err error) {
+	idx := strings.IndexByte(s, '~')
+	if idx < 0 {
+		return "", nil, ErrInvalidFormat
+	}
+	tag = s[:idx]
+	if !tagPattern.MatchString(tag) {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+	}
+	decoded, err = base64.RawURLEncoding.DecodeString(s[idx+1:])
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	if len(decoded) == 0 {
+		return "", nil, fmt.Errorf("%w: payload too short for a checksum byte", ErrInvalidEncoding)
+	}
+	return tag, decoded, nil
+}
+
+// taggedBase64Checksum is this SDK's own tagged-base64 checksum function:
+// the first byte of a SHA-256 over the tag bytes followed directly by the
+// value bytes, with no separator between them.
+// See EncodeTaggedBase64's doc comment for why it's not interchangeable
+// with the Rust crate's checksum.
+func taggedBase64Checksum(tag string, value []byte) byte {
+	h := sha256.New()
+	h.Write([]byte(tag))
+	h.Write(value)
+	return h.Sum(nil)[0]
+}
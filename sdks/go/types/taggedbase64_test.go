@@ -0,0 +1,89 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEncodeDecodeTaggedBase64RoundTrips(t *testing.T) {
+	s, err := EncodeTaggedBase64("BLOCK", []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("EncodeTaggedBase64: %v", err)
+	}
+
+	tag, value, err := DecodeTaggedBase64(s)
+	if err != nil {
+		t.Fatalf("DecodeTaggedBase64: %v", err)
+	}
+	if tag != "BLOCK" {
+		t.Fatalf("got tag %q, want BLOCK", tag)
+	}
+	if string(value) != "\x01\x02\x03" {
+		t.Fatalf("got value %v, want [1 2 3]", value)
+	}
+}
+
+func TestEncodeTaggedBase64RejectsNonAlphanumericTag(t *testing.T) {
+	_, err := EncodeTaggedBase64("BLOCK~", []byte{1})
+	if !errors.Is(err, ErrInvalidTag) {
+		t.Fatalf("got %v, want ErrInvalidTag", err)
+	}
+}
+
+func TestDecodeTaggedBase64RejectsMissingSeparator(t *testing.T) {
+	_, _, err := DecodeTaggedBase64("no-separator-here")
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Fatalf("got %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestDecodeTaggedBase64RejectsCorruptedChecksum(t *testing.T) {
+	s, err := EncodeTaggedBase64("TX", []byte{9, 9, 9})
+	if err != nil {
+		t.Fatalf("EncodeTaggedBase64: %v", err)
+	}
+
+	// Flip the last character of the base64 payload, corrupting the
+	// trailing checksum byte without changing the string's length.
+	corrupted := []byte(s)
+	last := corrupted[len(corrupted)-1]
+	if last == 'A' {
+		corrupted[len(corrupted)-1] = 'B'
+	} else {
+		corrupted[len(corrupted)-1] = 'A'
+	}
+
+	_, _, err = DecodeTaggedBase64(string(corrupted))
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("got %v, want ErrChecksumMismatch", err)
+	}
+}
+
+func TestParseTaggedBase64IgnoresChecksum(t *testing.T) {
+	// A string this SDK didn't encode - its checksum byte won't match
+	// taggedBase64Checksum, but ParseTaggedBase64 shouldn't care.
+	tag, value, err := ParseTaggedBase64("VID~AQIDBA")
+	if err != nil {
+		t.Fatalf("ParseTaggedBase64: %v", err)
+	}
+	if tag != "VID" {
+		t.Fatalf("got tag %q, want VID", tag)
+	}
+	if string(value) != "\x01\x02\x03" {
+		t.Fatalf("got value %v, want [1 2 3]", value)
+	}
+}
+
+func TestCommitmentTagDecodesTagAndValue(t *testing.T) {
+	c := Commitment("BLOCK~AQIDBA")
+	tag, value, err := c.Tag()
+	if err != nil {
+		t.Fatalf("Tag: %v", err)
+	}
+	if tag != "BLOCK" {
+		t.Fatalf("got tag %q, want BLOCK", tag)
+	}
+	if string(value) != "\x01\x02\x03" {
+		t.Fatalf("got value %v, want [1 2 3]", value)
+	}
+}
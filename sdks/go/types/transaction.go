@@ -0,0 +1,122 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MaxTransactionPayloadSize is the default maximum payload size, in bytes,
+// Validate accepts. It matches the sequencer's default ChainConfig's
+// max_block_size (see sequencer/src/chain_config.rs's Default impl) - a
+// whole block only fits one transaction of this size in the worst case,
+// so it's a conservative per-transaction ceiling, not a guarantee any
+// particular chain allows a payload this large. A chain running with a
+// different max_block_size should validate against that value instead,
+// via ValidateWithLimit.
+const MaxTransactionPayloadSize = 10240
+
+var (
+	// ErrEmptyPayload is returned by Validate when a transaction's payload
+	// is empty; the sequencer rejects these outright.
+	ErrEmptyPayload = errors.New("types: transaction payload is empty")
+	// ErrPayloadTooLarge is returned by Validate when a transaction's
+	// payload exceeds the checked limit, catching locally what would
+	// otherwise come back from the query service as a 413 (see
+	// client.ErrPayloadTooLarge) only after the payload was uploaded.
+	ErrPayloadTooLarge = errors.New("types: transaction payload exceeds the maximum size")
+	// ErrReservedNamespace is returned by Validate when a transaction
+	// targets SystemNamespace, which is reserved for the sequencer's own
+	// use and can't be submitted to by rollups.
+	ErrReservedNamespace = errors.New("types: namespace is reserved for the sequencer's own use")
+)
+
+// Validate checks tx against MaxTransactionPayloadSize and this SDK's
+// namespace constraints, catching the same submissions the sequencer
+// would reject, but before the payload is uploaded rather than after.
+//
+// Use ValidateWithLimit instead if the target chain's chain config
+// specifies a different max_block_size than the default.
+func (tx Transaction) Validate() error {
+	return tx.ValidateWithLimit(MaxTransactionPayloadSize)
+}
+
+// ValidateWithLimit is Validate against maxPayloadSize instead of
+// MaxTransactionPayloadSize, for callers that already have the target
+// chain's own max_block_size (e.g. from its fetched chain config).
+func (tx Transaction) ValidateWithLimit(maxPayloadSize uint64) error {
+	if len(tx.Payload) == 0 {
+		return ErrEmptyPayload
+	}
+	if uint64(len(tx.Payload)) > maxPayloadSize {
+		return fmt.Errorf("%w: %d bytes exceeds %d byte limit", ErrPayloadTooLarge, len(tx.Payload), maxPayloadSize)
+	}
+	if !tx.Namespace.Valid() {
+		return fmt.Errorf("%w: %d", ErrNamespaceIDOutOfRange, tx.Namespace)
+	}
+	if tx.Namespace.IsSystem() {
+		return ErrReservedNamespace
+	}
+	return nil
+}
+
+// Clone returns a deep copy of tx: mutating the result's Payload doesn't
+// affect tx's, and vice versa.
+func (tx Transaction) Clone() Transaction {
+	clone := tx
+	if tx.Payload != nil {
+		clone.Payload = append([]byte(nil), tx.Payload...)
+	}
+	return clone
+}
+
+// Equal reports whether tx and other target the same namespace with the
+// same payload bytes.
+func (tx Transaction) Equal(other Transaction) bool {
+	return tx.Namespace == other.Namespace && bytes.Equal(tx.Payload, other.Payload)
+}
+
+// MarshalJSONForJS encodes tx the same way its default JSON encoding does,
+// except Namespace is a JSON string rather than a number - see
+// Uint64String's doc comment for why. Call this explicitly at whichever
+// call site's output reaches a JavaScript frontend; every other consumer
+// should keep decoding Namespace as a plain number via json.Marshal(tx).
+func (tx Transaction) MarshalJSONForJS() ([]byte, error) {
+	return json.Marshal(struct {
+		Namespace Uint64String `json:"namespace"`
+		Payload   []byte       `json:"payload"`
+	}{Uint64String(tx.Namespace), tx.Payload})
+}
+
+// MarshalCBOR encodes tx as a canonical CBOR map with the same field names
+// as its JSON encoding, except that Payload is a native CBOR byte string
+// rather than the base64 text JSON represents it as - see cbor.go's
+// package doc comment for why that matters for compact storage.
+func (tx Transaction) MarshalCBOR() ([]byte, error) {
+	return EncodeCBORTextKeyedMap(map[string][]byte{
+		"namespace": EncodeCBORUint64(uint64(tx.Namespace)),
+		"payload":   EncodeCBORBytes(tx.Payload),
+	}), nil
+}
+
+// UnmarshalCBOR decodes data produced by MarshalCBOR into tx.
+func (tx *Transaction) UnmarshalCBOR(data []byte) error {
+	fields, rest, err := DecodeCBORTextKeyedMap(data)
+	if err != nil {
+		return fmt.Errorf("types: decode cbor transaction: %w", err)
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("types: %d trailing bytes after cbor transaction", len(rest))
+	}
+	namespace, _, err := DecodeCBORUint64(fields["namespace"])
+	if err != nil {
+		return fmt.Errorf("types: decode cbor transaction namespace: %w", err)
+	}
+	payload, _, err := DecodeCBORBytes(fields["payload"])
+	if err != nil {
+		return fmt.Errorf("types: decode cbor transaction payload: %w", err)
+	}
+	*tx = Transaction{Namespace: NamespaceId(namespace), Payload: payload}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestTransactionCloneIsIndependent(t *testing.T) {
+	tx := Transaction{Namespace: 1, Payload: []byte("hello")}
+	clone := tx.Clone()
+	clone.Payload[0] = 'H'
+
+	if tx.Payload[0] != 'h' {
+		t.Fatalf("mutating the clone's payload changed the original: %q", tx.Payload)
+	}
+	if !tx.Equal(Transaction{Namespace: 1, Payload: []byte("hello")}) {
+		t.Fatalf("original should still equal its unmodified value")
+	}
+	if tx.Equal(clone) {
+		t.Fatalf("mutated clone should no longer equal the original")
+	}
+}
+
+func TestTransactionValidateAcceptsWellFormed(t *testing.T) {
+	tx := Transaction{Namespace: 1, Payload: []byte("hello")}
+	if err := tx.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
+func TestTransactionValidateRejectsEmptyPayload(t *testing.T) {
+	tx := Transaction{Namespace: 1, Payload: nil}
+	if err := tx.Validate(); !errors.Is(err, ErrEmptyPayload) {
+		t.Fatalf("got %v, want ErrEmptyPayload", err)
+	}
+}
+
+func TestTransactionValidateRejectsOversizedPayload(t *testing.T) {
+	tx := Transaction{Namespace: 1, Payload: bytes.Repeat([]byte{0}, MaxTransactionPayloadSize+1)}
+	if err := tx.Validate(); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("got %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+func TestTransactionValidateRejectsOutOfRangeNamespace(t *testing.T) {
+	tx := Transaction{Namespace: NamespaceId(1 << 33), Payload: []byte("hello")}
+	if err := tx.Validate(); !errors.Is(err, ErrNamespaceIDOutOfRange) {
+		t.Fatalf("got %v, want ErrNamespaceIDOutOfRange", err)
+	}
+}
+
+func TestTransactionValidateRejectsSystemNamespace(t *testing.T) {
+	tx := Transaction{Namespace: SystemNamespace, Payload: []byte("hello")}
+	if err := tx.Validate(); !errors.Is(err, ErrReservedNamespace) {
+		t.Fatalf("got %v, want ErrReservedNamespace", err)
+	}
+}
+
+func TestTransactionValidateWithLimitUsesGivenLimit(t *testing.T) {
+	tx := Transaction{Namespace: 1, Payload: []byte("hello")}
+	if err := tx.ValidateWithLimit(4); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("got %v, want ErrPayloadTooLarge", err)
+	}
+	if err := tx.ValidateWithLimit(5); err != nil {
+		t.Fatalf("ValidateWithLimit(5): %v", err)
+	}
+}
@@ -0,0 +1,279 @@
+// Package types contains the Go representations of the data types exchanged
+// with the Espresso Sequencer's query and submit APIs.
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// HeaderImpl mirrors the fields of the sequencer's `Header` that are stable
+// across the currently supported API versions. Fields that vary by header
+// version are added as the SDK gains support for them.
+//
+// Unmarshaling (see UnmarshalJSON in header.go) tolerates header shapes this
+// SDK doesn't fully recognize: Version reports whether the fields below were
+// populated, and RawFields preserves every top-level field verbatim so a node
+// upgrade that reaches this SDK before a matching release does doesn't halt
+// decoding outright.
+type HeaderImpl struct {
+	Height    uint64 `json:"height"`
+	Timestamp uint64 `json:"timestamp"`
+	// L1Head is the L1 block number this L2 block was sequenced against.
+	// Unlike L1Finalized, it's just a number, with no timestamp or hash,
+	// because the L1 head is subject to reorgs: a different block may end
+	// up at this height, and the Espresso header deliberately commits to
+	// nothing about it that a reorg could invalidate.
+	L1Head            uint64 `json:"l1_head"`
+	PayloadCommitment string `json:"payload_commitment"`
+	BuilderCommitment string `json:"builder_commitment"`
+	NsTable           string `json:"ns_table"`
+	BlockMerkleRoot   string `json:"block_merkle_tree_root"`
+	FeeMerkleRoot     string `json:"fee_merkle_tree_root"`
+
+	// ChainConfig is a commitment to the chain's parameters, or the full
+	// parameters themselves if the proposer chose to include them. It is
+	// not one of headerV0Fields, so it's left nil rather than causing a
+	// fallback to HeaderVersionUnknown when decoding a header JSON that
+	// predates this field.
+	ChainConfig *ResolvableChainConfig `json:"chain_config,omitempty"`
+	// L1Finalized is the latest L1 block finalized at the time this header
+	// was sequenced, or nil in the rare case where Espresso started before
+	// the L1 had finalized any block. See L1Head's doc comment for how this
+	// differs from it. Like ChainConfig, it isn't one of headerV0Fields.
+	L1Finalized *L1BlockInfo `json:"l1_finalized,omitempty"`
+
+	// Version reports which shape UnmarshalJSON recognized: HeaderVersionV0
+	// if the fields above were populated from the decoded JSON,
+	// HeaderVersionV3 if the epoch-era fields in Epoch were decoded as well,
+	// or HeaderVersionUnknown if they were left zero-valued because the payload
+	// didn't match. It is the zero value (empty string) on a HeaderImpl built
+	// directly rather than unmarshaled.
+	Version HeaderVersion `json:"-"`
+	// RawFields holds every top-level field of the decoded header JSON,
+	// verbatim, regardless of Version. Callers that need a field this SDK
+	// doesn't expose yet can recover it from here instead of failing.
+	RawFields map[string]json.RawMessage `json:"-"`
+	// Epoch holds the epoch-era fields added in HeaderVersionV3, or nil for
+	// any other version. Prefer the Header interface's EpochFields accessor
+	// over reading this directly.
+	Epoch *EpochHeaderFields `json:"-"`
+}
+
+// QuorumCertificate is HotShot's proof that a supermajority of the stake
+// table voted to commit a leaf.
+type QuorumCertificate struct {
+	View      uint64             `json:"view_number"`
+	LeafHash  Commitment         `json:"leaf_commitment"`
+	Signature AggregateSignature `json:"signatures"`
+}
+
+// Leaf is a single entry in the HotShot chain: a header together with the
+// quorum certificate that finalized it. Light-client-style consumers follow
+// the chain through leaves rather than trusting headers in isolation.
+type Leaf struct {
+	Height           uint64            `json:"height"`
+	View             uint64            `json:"view_number"`
+	Header           HeaderImpl        `json:"block_header"`
+	QC               QuorumCertificate `json:"quorum_certificate"`
+	ParentCommitment Commitment        `json:"parent_commitment"`
+}
+
+// Transaction is a namespaced payload submitted to the sequencer.
+type Transaction struct {
+	Namespace NamespaceId `json:"namespace"`
+	Payload   []byte      `json:"payload"`
+}
+
+// NamespaceProof attests that a set of transactions is the complete set of
+// transactions belonging to a namespace within a block's payload.
+type NamespaceProof struct {
+	Namespace    NamespaceId   `json:"namespace"`
+	Proof        []byte        `json:"proof"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// MarshalCBOR encodes p as a canonical CBOR map with the same field names
+// as its JSON encoding; see Transaction.MarshalCBOR for why Proof and each
+// transaction's Payload are native CBOR byte strings rather than base64
+// text. This is the encoding cbor.go's package doc comment calls out as
+// the intended use case: compact storage of proofs in a rollup's database.
+func (p NamespaceProof) MarshalCBOR() ([]byte, error) {
+	txs := EncodeCBORArrayHeader(len(p.Transactions))
+	for _, tx := range p.Transactions {
+		encoded, err := tx.MarshalCBOR()
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, encoded...)
+	}
+	return EncodeCBORTextKeyedMap(map[string][]byte{
+		"namespace":    EncodeCBORUint64(uint64(p.Namespace)),
+		"proof":        EncodeCBORBytes(p.Proof),
+		"transactions": txs,
+	}), nil
+}
+
+// UnmarshalCBOR decodes data produced by MarshalCBOR into p.
+func (p *NamespaceProof) UnmarshalCBOR(data []byte) error {
+	fields, rest, err := DecodeCBORTextKeyedMap(data)
+	if err != nil {
+		return fmt.Errorf("types: decode cbor namespace proof: %w", err)
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("types: %d trailing bytes after cbor namespace proof", len(rest))
+	}
+
+	namespace, _, err := DecodeCBORUint64(fields["namespace"])
+	if err != nil {
+		return fmt.Errorf("types: decode cbor namespace proof namespace: %w", err)
+	}
+	proof, _, err := DecodeCBORBytes(fields["proof"])
+	if err != nil {
+		return fmt.Errorf("types: decode cbor namespace proof proof: %w", err)
+	}
+
+	n, rest, err := DecodeCBORArrayHeader(fields["transactions"])
+	if err != nil {
+		return fmt.Errorf("types: decode cbor namespace proof transactions: %w", err)
+	}
+	txs := make([]Transaction, n)
+	for i := 0; i < n; i++ {
+		txStart := rest
+		rest, err = skipCBORValue(rest)
+		if err != nil {
+			return fmt.Errorf("types: decode cbor namespace proof transactions[%d]: %w", i, err)
+		}
+		if err := txs[i].UnmarshalCBOR(txStart[:len(txStart)-len(rest)]); err != nil {
+			return fmt.Errorf("types: decode cbor namespace proof transactions[%d]: %w", i, err)
+		}
+	}
+
+	*p = NamespaceProof{Namespace: NamespaceId(namespace), Proof: proof, Transactions: txs}
+	return nil
+}
+
+// Clone returns a deep copy of p: mutating the result's Proof or
+// Transactions doesn't affect p's, and vice versa.
+func (p NamespaceProof) Clone() NamespaceProof {
+	clone := p
+	if p.Proof != nil {
+		clone.Proof = append([]byte(nil), p.Proof...)
+	}
+	if p.Transactions != nil {
+		clone.Transactions = make([]Transaction, len(p.Transactions))
+		for i, tx := range p.Transactions {
+			clone.Transactions[i] = tx.Clone()
+		}
+	}
+	return clone
+}
+
+// Equal reports whether p and other attest to the same namespace, with the
+// same proof bytes and the same transactions in the same order.
+func (p NamespaceProof) Equal(other NamespaceProof) bool {
+	if p.Namespace != other.Namespace || !bytes.Equal(p.Proof, other.Proof) || len(p.Transactions) != len(other.Transactions) {
+		return false
+	}
+	for i := range p.Transactions {
+		if !p.Transactions[i].Equal(other.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSONForJS encodes p the same way its default JSON encoding does,
+// except Namespace and each transaction's Namespace are JSON strings
+// rather than numbers - see Uint64String's doc comment for why. Call this
+// explicitly at whichever call site's output reaches a JavaScript
+// frontend; every other consumer should keep using json.Marshal(p).
+func (p NamespaceProof) MarshalJSONForJS() ([]byte, error) {
+	txs := make([]json.RawMessage, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		encoded, err := tx.MarshalJSONForJS()
+		if err != nil {
+			return nil, fmt.Errorf("types: encode namespace proof transaction %d for js: %w", i, err)
+		}
+		txs[i] = encoded
+	}
+	return json.Marshal(struct {
+		Namespace    Uint64String      `json:"namespace"`
+		Proof        []byte            `json:"proof"`
+		Transactions []json.RawMessage `json:"transactions"`
+	}{Uint64String(p.Namespace), p.Proof, txs})
+}
+
+// Commitment is a typed wrapper around the sequencer's string-encoded
+// commitments (payload, builder, block merkle root, ...), so verification
+// code compares commitments against each other rather than against an
+// unrelated string by mistake.
+type Commitment string
+
+// Tag decodes a commitment's tagged-base64 tag and value, e.g.
+// "BLOCK~AAAA..." -> ("BLOCK", []byte{...}). Commitments come from the
+// sequencer node, not this SDK, so this uses ParseTaggedBase64 rather than
+// DecodeTaggedBase64 - see its doc comment for why.
+func (c Commitment) Tag() (tag string, value []byte, err error) {
+	return ParseTaggedBase64(string(c))
+}
+
+// String returns c's tagged-base64 representation, e.g. for logging.
+func (c Commitment) String() string {
+	return string(c)
+}
+
+// MarshalText implements encoding.TextMarshaler, so a Commitment round-trips
+// through config files and CLI flags (encoding/json already marshals it as
+// a plain string via its underlying type, but TOML/YAML libraries and
+// flag.TextVar need MarshalText/UnmarshalText).
+func (c Commitment) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler; see MarshalText.
+func (c *Commitment) UnmarshalText(text []byte) error {
+	*c = Commitment(text)
+	return nil
+}
+
+// BlockMerkleProof attests that the block at Height is included in the
+// block merkle tree rooted at a header's BlockMerkleRoot.
+type BlockMerkleProof struct {
+	Height uint64   `json:"height"`
+	Path   []string `json:"path"`
+}
+
+// PathLength returns the number of sibling hashes in the proof, i.e. the
+// merkle tree's depth at the time the proof was generated. This is the one
+// property of Path that's useful to inspect without knowing how to decode
+// its entries; see verification.VerifyMerkleProof for actually checking
+// the proof, which re-serializes Path itself rather than this count.
+func (p BlockMerkleProof) PathLength() int {
+	return len(p.Path)
+}
+
+// Clone returns a deep copy of p: mutating the result's Path doesn't
+// affect p's, and vice versa.
+func (p BlockMerkleProof) Clone() BlockMerkleProof {
+	clone := p
+	if p.Path != nil {
+		clone.Path = append([]string(nil), p.Path...)
+	}
+	return clone
+}
+
+// Equal reports whether p and other attest to the same height along the
+// same path.
+func (p BlockMerkleProof) Equal(other BlockMerkleProof) bool {
+	if p.Height != other.Height || len(p.Path) != len(other.Path) {
+		return false
+	}
+	for i := range p.Path {
+		if p.Path[i] != other.Path[i] {
+			return false
+		}
+	}
+	return true
+}
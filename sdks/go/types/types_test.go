@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCommitmentTextRoundTrips(t *testing.T) {
+	c := Commitment("BLOCK~AAAA")
+	text, err := c.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	if string(text) != "BLOCK~AAAA" {
+		t.Fatalf("got %s, want BLOCK~AAAA", text)
+	}
+
+	var decoded Commitment
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if decoded != c {
+		t.Fatalf("got %q, want %q", decoded, c)
+	}
+	if decoded.String() != "BLOCK~AAAA" {
+		t.Fatalf("String: got %q, want BLOCK~AAAA", decoded.String())
+	}
+}
+
+func TestLeafUnmarshalJSONPopulatesTypedFields(t *testing.T) {
+	data := []byte(`{
+		"height": 5,
+		"view_number": 9,
+		"block_header": {
+			"height": 5,
+			"timestamp": 100,
+			"l1_head": 7,
+			"payload_commitment": "p",
+			"builder_commitment": "b",
+			"ns_table": "n",
+			"block_merkle_tree_root": "r1",
+			"fee_merkle_tree_root": "r2"
+		},
+		"quorum_certificate": {
+			"view_number": 9,
+			"leaf_commitment": "LEAF~AAAA",
+			"signatures": "SIG~BBBB"
+		},
+		"parent_commitment": "LEAF~CCCC"
+	}`)
+
+	var leaf Leaf
+	if err := json.Unmarshal(data, &leaf); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if leaf.QC.LeafHash != Commitment("LEAF~AAAA") {
+		t.Fatalf("got leaf hash %q, want LEAF~AAAA", leaf.QC.LeafHash)
+	}
+	if leaf.QC.Signature != AggregateSignature("SIG~BBBB") {
+		t.Fatalf("got signature %q, want SIG~BBBB", leaf.QC.Signature)
+	}
+	if leaf.ParentCommitment != Commitment("LEAF~CCCC") {
+		t.Fatalf("got parent commitment %q, want LEAF~CCCC", leaf.ParentCommitment)
+	}
+	if leaf.Header.Version != HeaderVersionV0 {
+		t.Fatalf("got header version %q, want %q", leaf.Header.Version, HeaderVersionV0)
+	}
+}
+
+func TestBlockMerkleProofPathLength(t *testing.T) {
+	p := BlockMerkleProof{Height: 3, Path: []string{"a", "b", "c"}}
+	if p.PathLength() != 3 {
+		t.Fatalf("PathLength() = %d, want 3", p.PathLength())
+	}
+	if (BlockMerkleProof{}).PathLength() != 0 {
+		t.Fatalf("PathLength() of zero value != 0")
+	}
+}
+
+func TestBlockMerkleProofCloneIsIndependent(t *testing.T) {
+	p := BlockMerkleProof{Height: 3, Path: []string{"a", "b"}}
+	clone := p.Clone()
+	clone.Path[0] = "z"
+
+	if p.Path[0] != "a" {
+		t.Fatalf("mutating the clone's Path changed the original: %v", p.Path)
+	}
+	if !p.Equal(BlockMerkleProof{Height: 3, Path: []string{"a", "b"}}) {
+		t.Fatalf("original should still equal its unmodified value")
+	}
+	if p.Equal(clone) {
+		t.Fatalf("mutated clone should no longer equal the original")
+	}
+}
+
+func TestNamespaceProofCloneIsIndependent(t *testing.T) {
+	p := NamespaceProof{
+		Namespace:    1,
+		Proof:        []byte("proof"),
+		Transactions: []Transaction{{Namespace: 1, Payload: []byte("tx")}},
+	}
+	clone := p.Clone()
+	clone.Proof[0] = 'P'
+	clone.Transactions[0].Payload[0] = 'T'
+
+	if p.Proof[0] != 'p' {
+		t.Fatalf("mutating the clone's Proof changed the original: %v", p.Proof)
+	}
+	if p.Transactions[0].Payload[0] != 't' {
+		t.Fatalf("mutating the clone's transaction changed the original: %v", p.Transactions[0].Payload)
+	}
+	want := NamespaceProof{
+		Namespace:    1,
+		Proof:        []byte("proof"),
+		Transactions: []Transaction{{Namespace: 1, Payload: []byte("tx")}},
+	}
+	if !p.Equal(want) {
+		t.Fatalf("original should still equal its unmodified value")
+	}
+	if p.Equal(clone) {
+		t.Fatalf("mutated clone should no longer equal the original")
+	}
+}
+
+func TestRewardAccountProofPathLength(t *testing.T) {
+	p := RewardAccountProof{
+		Account: RewardAccountState{Address: "0xabc", Balance: "100"},
+		Path:    []string{"a", "b"},
+	}
+	if p.PathLength() != 2 {
+		t.Fatalf("PathLength() = %d, want 2", p.PathLength())
+	}
+	if (RewardAccountProof{}).PathLength() != 0 {
+		t.Fatalf("PathLength() of zero value != 0")
+	}
+}
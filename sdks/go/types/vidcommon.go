@@ -0,0 +1,54 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// VidCommon is the sequencer's VID scheme commitment for a block's payload,
+// needed alongside a namespace proof to verify a transaction's inclusion
+// (see verification.VerifyNamespace). Its internal layout comes from the
+// external jf_primitives VID scheme implementation, which isn't vendored in
+// this tree, so VidCommon treats the data as an opaque blob rather than
+// guessing at field names for "scheme parameters" it has no way to verify.
+// Raw preserves the exact bytes the query service returned, unchanged by a
+// marshal/unmarshal round trip, for byte-exact use as verification FFI
+// input; Len is the one property that's safe to expose without parsing the
+// contents.
+type VidCommon struct {
+	Raw []byte
+}
+
+// Len returns the length of the raw VID common data in bytes.
+func (v VidCommon) Len() int {
+	return len(v.Raw)
+}
+
+// MarshalJSON encodes v the same way a bare []byte field would, so
+// wrapping VidCommon in this type doesn't change the wire format.
+func (v VidCommon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Raw)
+}
+
+// UnmarshalJSON decodes data the same way a bare []byte field would; see
+// MarshalJSON.
+func (v *VidCommon) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.Raw)
+}
+
+// Clone returns a deep copy of v: mutating the result's Raw doesn't affect
+// v's backing array, and vice versa. Copying a VidCommon by value leaves
+// both copies pointing at the same Raw slice, which matters here because
+// Raw is handed to FFI calls that expect it not to change out from under
+// them mid-call.
+func (v VidCommon) Clone() VidCommon {
+	if v.Raw == nil {
+		return VidCommon{}
+	}
+	return VidCommon{Raw: append([]byte(nil), v.Raw...)}
+}
+
+// Equal reports whether v and other hold the same raw bytes.
+func (v VidCommon) Equal(other VidCommon) bool {
+	return bytes.Equal(v.Raw, other.Raw)
+}
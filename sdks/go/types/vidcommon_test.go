@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVidCommonJSONRoundTrips(t *testing.T) {
+	data, err := json.Marshal(VidCommon{Raw: []byte{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"AQID"` {
+		t.Fatalf("got %s, want \"AQID\"", data)
+	}
+
+	var v VidCommon
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if string(v.Raw) != "\x01\x02\x03" {
+		t.Fatalf("got %v, want [1 2 3]", v.Raw)
+	}
+	if v.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", v.Len())
+	}
+}
+
+func TestVidCommonLenEmpty(t *testing.T) {
+	var v VidCommon
+	if v.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", v.Len())
+	}
+}
+
+func TestVidCommonCloneIsIndependent(t *testing.T) {
+	v := VidCommon{Raw: []byte{1, 2, 3}}
+	clone := v.Clone()
+	clone.Raw[0] = 9
+
+	if v.Raw[0] != 1 {
+		t.Fatalf("mutating the clone's Raw changed the original: %v", v.Raw)
+	}
+	if !v.Equal(VidCommon{Raw: []byte{1, 2, 3}}) {
+		t.Fatalf("original should still equal its unmodified value")
+	}
+	if v.Equal(clone) {
+		t.Fatalf("mutated clone should no longer equal the original")
+	}
+}
@@ -0,0 +1,126 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#include <stddef.h>
+
+// verify_namespace_proof_batch verifies len(out_results) proofs in one
+// call, amortizing cgo's per-call overhead and letting the Rust side
+// parallelize across them. requests_json is a JSON array of
+// {"header":..,"vid_common":..,"proof":..} objects, each field base64
+// encoded, matching NamespaceProofInput's MarshalJSON. out_results is
+// caller-allocated with one int32 slot per request, written per
+// interpretResult's codeXxx convention in errors.go. Returns 0 on success,
+// -1 if requests_json itself is malformed (in which case out_results is
+// left untouched).
+extern int verify_namespace_proof_batch(
+	const unsigned char *requests_json, size_t requests_len,
+	int *out_results, size_t out_results_len
+);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+)
+
+// NamespaceProofInput is one request to VerifyNamespaceBatch: the same
+// three byte-exact JSON documents VerifyNamespace takes individually.
+type NamespaceProofInput struct {
+	HeaderJSON    []byte
+	VidCommonJSON []byte
+	ProofJSON     []byte
+}
+
+// namespaceProofInputJSON is the wire shape sent to the native batch
+// verifier; json.Marshal base64-encodes the []byte fields automatically.
+type namespaceProofInputJSON struct {
+	Header    []byte `json:"header"`
+	VidCommon []byte `json:"vid_common"`
+	Proof     []byte `json:"proof"`
+}
+
+// Result is one entry of VerifyNamespaceBatch's output, in the same order
+// as the inputs.
+type Result struct {
+	Err error
+}
+
+// VerifyNamespaceBatch verifies many namespace proofs in a single call into
+// the native verifier, instead of paying cgo's per-call overhead once per
+// proof. This matters during rollup catch-up, which can verify hundreds of
+// historical blocks in a tight loop.
+//
+// ctx is checked before the batch starts and raced against the call once
+// it's in flight, the same way VerifyNamespace handles ctx; see its doc
+// comment for the caveat that the native call itself is not interruptible.
+func VerifyNamespaceBatch(ctx context.Context, inputs []NamespaceProofInput) ([]Result, error) {
+	if libraryVersionErr != nil {
+		return nil, libraryVersionErr
+	}
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	wire := make([]namespaceProofInputJSON, len(inputs))
+	for i, in := range inputs {
+		if err := validateJSONInput("header", in.HeaderJSON); err != nil {
+			return nil, fmt.Errorf("verification: input %d: %w", i, err)
+		}
+		if err := validateJSONInput("vid_common", in.VidCommonJSON); err != nil {
+			return nil, fmt.Errorf("verification: input %d: %w", i, err)
+		}
+		if err := validateJSONInput("proof", in.ProofJSON); err != nil {
+			return nil, fmt.Errorf("verification: input %d: %w", i, err)
+		}
+		if err := validateHeaderSchema(in.HeaderJSON); err != nil {
+			return nil, fmt.Errorf("verification: input %d: %w", i, err)
+		}
+		if err := validateNamespaceProofSchema(in.ProofJSON); err != nil {
+			return nil, fmt.Errorf("verification: input %d: %w", i, err)
+		}
+		wire[i] = namespaceProofInputJSON{Header: in.HeaderJSON, VidCommon: in.VidCommonJSON, Proof: in.ProofJSON}
+	}
+
+	requestsJSON, err := json.Marshal(wire)
+	if err != nil {
+		return nil, fmt.Errorf("verification: marshal batch: %w", err)
+	}
+
+	outResults := make([]C.int, len(inputs))
+	type batchOutcome struct {
+		ret C.int
+	}
+	done := make(chan batchOutcome, 1)
+	go func() {
+		ret := C.verify_namespace_proof_batch(
+			(*C.uchar)(unsafe.Pointer(&requestsJSON[0])), C.size_t(len(requestsJSON)),
+			(*C.int)(unsafe.Pointer(&outResults[0])), C.size_t(len(outResults)),
+		)
+		done <- batchOutcome{ret: ret}
+	}()
+
+	var outcome batchOutcome
+	select {
+	case outcome = <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if outcome.ret != 0 {
+		return nil, fmt.Errorf("verification: malformed batch request rejected by native verifier")
+	}
+
+	results := make([]Result, len(inputs))
+	for i, r := range outResults {
+		results[i] = Result{Err: interpretResult(int32(r))}
+	}
+	return results, nil
+}
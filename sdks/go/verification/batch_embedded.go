@@ -0,0 +1,34 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import "context"
+
+// NamespaceProofInput is one request to VerifyNamespaceBatch: the same
+// three byte-exact JSON documents VerifyNamespace takes individually.
+type NamespaceProofInput struct {
+	HeaderJSON    []byte
+	VidCommonJSON []byte
+	ProofJSON     []byte
+}
+
+// Result is one entry of VerifyNamespaceBatch's output, in the same order
+// as the inputs.
+type Result struct {
+	Err error
+}
+
+// VerifyNamespaceBatch verifies each input with VerifyNamespace in turn.
+// Batching a dlopen'd function pointer call doesn't amortize the way a
+// single cgo call does, so this is a plain loop; only the cgo-linked build
+// gets the single-call batch path.
+func VerifyNamespaceBatch(ctx context.Context, inputs []NamespaceProofInput) ([]Result, error) {
+	results := make([]Result, len(inputs))
+	for i, in := range inputs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		results[i] = Result{Err: VerifyNamespace(ctx, in.HeaderJSON, in.VidCommonJSON, in.ProofJSON)}
+	}
+	return results, nil
+}
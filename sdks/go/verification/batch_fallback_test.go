@@ -0,0 +1,36 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestVerifyNamespaceBatchRunsEachInput(t *testing.T) {
+	valid := NamespaceProofInput{
+		HeaderJSON:    completeHeaderJSON("deadbeef"),
+		VidCommonJSON: []byte(`"AQ=="`),
+		ProofJSON:     []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`),
+	}
+	invalid := NamespaceProofInput{
+		HeaderJSON:    completeHeaderJSON(""),
+		VidCommonJSON: []byte(`"AQ=="`),
+		ProofJSON:     []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`),
+	}
+
+	results, err := VerifyNamespaceBatch(context.Background(), []NamespaceProofInput{valid, invalid})
+	if err != nil {
+		t.Fatalf("VerifyNamespaceBatch: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results", len(results))
+	}
+	if results[0].Err != nil {
+		t.Fatalf("expected first input valid, got %v", results[0].Err)
+	}
+	if !errors.Is(results[1].Err, ErrNamespaceMismatch) {
+		t.Fatalf("expected second input invalid, got %v", results[1].Err)
+	}
+}
@@ -0,0 +1,118 @@
+package verification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// CacheStats is a snapshot of a Cache's hit rate, for exporting as metrics.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// Cache memoizes VerifyNamespace and VerifyMerkleProof results keyed by a
+// hash of their inputs, so re-verifying the same proof - e.g. a rollup
+// replaying blocks it already checked before a process restart, working
+// off a persisted catch-up cursor that doesn't itself remember which
+// proofs passed - is a map lookup instead of a repeat trip through the
+// native library. It is optional: callers that don't expect repeat
+// verifications of the same input should call VerifyNamespace /
+// VerifyMerkleProof directly rather than pay for a cache that never hits.
+//
+// A Cache never evicts; callers that verify an unbounded or very large
+// number of distinct blocks over a process's lifetime should size their
+// use of it accordingly (e.g. one Cache per catch-up run) rather than
+// share a single long-lived instance.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[[32]byte]error
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+}
+
+// NewCache creates an empty Cache.
+func NewCache() *Cache {
+	return &Cache{entries: make(map[[32]byte]error)}
+}
+
+// cacheKey hashes parts together, length-prefixing each so that, e.g.,
+// ("ab", "c") and ("a", "bc") don't collide.
+func cacheKey(parts ...[]byte) [32]byte {
+	h := sha256.New()
+	var lenBuf [8]byte
+	for _, p := range parts {
+		binary.LittleEndian.PutUint64(lenBuf[:], uint64(len(p)))
+		h.Write(lenBuf[:])
+		h.Write(p)
+	}
+	return [32]byte(h.Sum(nil))
+}
+
+// lookup returns the cached error for key and true if present, else false;
+// it also records the hit or miss in c's stats.
+func (c *Cache) lookup(key [32]byte) (error, bool) {
+	c.mu.Lock()
+	err, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	return err, ok
+}
+
+// store records err for key, unless ctx was cancelled before the
+// underlying verification returned - a cancellation says nothing about
+// whether the proof is actually valid, so it must not be cached as if it
+// did.
+func (c *Cache) store(ctx context.Context, key [32]byte, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	c.mu.Lock()
+	c.entries[key] = err
+	c.mu.Unlock()
+}
+
+// VerifyNamespace returns the cached result of a prior identical call, or
+// calls VerifyNamespace and caches the result for next time.
+func (c *Cache) VerifyNamespace(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) error {
+	key := cacheKey(headerJSON, vidCommonJSON, proofJSON)
+	if err, ok := c.lookup(key); ok {
+		return err
+	}
+	err := VerifyNamespace(ctx, headerJSON, vidCommonJSON, proofJSON)
+	c.store(ctx, key, err)
+	return err
+}
+
+// VerifyMerkleProof returns the cached result of a prior identical call, or
+// calls VerifyMerkleProof and caches the result for next time. header and
+// proof are re-marshaled to JSON solely to compute the cache key; a
+// marshal failure here just means a guaranteed cache miss, not an error
+// returned to the caller, since VerifyMerkleProof will hit the same
+// failure itself.
+func (c *Cache) VerifyMerkleProof(ctx context.Context, header types.HeaderImpl, proof types.BlockMerkleProof, commitment types.Commitment) error {
+	headerJSON, _ := json.Marshal(header)
+	proofJSON, _ := json.Marshal(proof)
+	key := cacheKey(headerJSON, proofJSON, []byte(commitment))
+	if err, ok := c.lookup(key); ok {
+		return err
+	}
+	err := VerifyMerkleProof(ctx, header, proof, commitment)
+	c.store(ctx, key, err)
+	return err
+}
+
+// Stats returns the Cache's current hit/miss counts.
+func (c *Cache) Stats() CacheStats {
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
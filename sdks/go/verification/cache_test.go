@@ -0,0 +1,47 @@
+package verification
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCacheHitsOnRepeatedVerifyNamespace(t *testing.T) {
+	c := NewCache()
+	ctx := context.Background()
+
+	first := c.VerifyNamespace(ctx, nil, nil, nil)
+	if first == nil {
+		t.Fatal("expected error for empty input")
+	}
+	if stats := c.Stats(); stats.Misses != 1 || stats.Hits != 0 {
+		t.Fatalf("got stats %+v, want 1 miss, 0 hits", stats)
+	}
+
+	second := c.VerifyNamespace(ctx, nil, nil, nil)
+	if second != first {
+		t.Fatalf("got a different error on cache hit: %v vs %v", second, first)
+	}
+	if stats := c.Stats(); stats.Misses != 1 || stats.Hits != 1 {
+		t.Fatalf("got stats %+v, want 1 miss, 1 hit", stats)
+	}
+}
+
+func TestCacheDoesNotCacheCancellation(t *testing.T) {
+	c := NewCache()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := c.VerifyNamespace(ctx, []byte(`{}`), []byte(`"AQ=="`), []byte(`{}`)); err == nil {
+		t.Fatal("expected error for cancelled context")
+	}
+	if stats := c.Stats(); stats.Misses != 1 {
+		t.Fatalf("got stats %+v, want 1 miss", stats)
+	}
+
+	if err := c.VerifyNamespace(ctx, []byte(`{}`), []byte(`"AQ=="`), []byte(`{}`)); err == nil {
+		t.Fatal("expected error for cancelled context")
+	}
+	if stats := c.Stats(); stats.Misses != 2 || stats.Hits != 0 {
+		t.Fatalf("got stats %+v, want 2 misses, 0 hits (cancellation must not be cached)", stats)
+	}
+}
@@ -0,0 +1,46 @@
+package verification
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ErrNonConsecutiveHeight is returned by VerifyHeaderChain when two
+// adjacent headers don't differ in height by exactly one.
+var ErrNonConsecutiveHeight = errors.New("verification: headers are not consecutive")
+
+// ErrNonMonotonicTimestamp is returned by VerifyHeaderChain when a header's
+// timestamp is earlier than its predecessor's.
+var ErrNonMonotonicTimestamp = errors.New("verification: header timestamp precedes its predecessor's")
+
+// VerifyHeaderChain checks that headers, in order, form a consistent run of
+// the sequencer's chain: each header's height is exactly one more than the
+// previous header's, and timestamps never go backwards (HotShot headers may
+// repeat a timestamp across a view change, so this allows equal but not
+// decreasing). It returns the first violation found, wrapping
+// ErrNonConsecutiveHeight or ErrNonMonotonicTimestamp so callers can
+// distinguish the two with errors.Is.
+//
+// It does not check that each header links to the previous one via a parent
+// commitment: HeaderImpl, unlike Leaf, doesn't carry a parent_commitment
+// field to check against, and computing one from the header itself would
+// need HeaderImpl.Commit, which is not implemented (see
+// types.ErrHeaderCommitUnsupported). Callers who need that stronger
+// guarantee should verify the Leaf chain's QuorumCertificates instead, once
+// this SDK supports that; this function only catches the cheap, common-case
+// mistakes - gaps and reordering - that rollups following the chain
+// currently don't check for at all.
+func VerifyHeaderChain(headers []types.HeaderImpl) error {
+	for i := 1; i < len(headers); i++ {
+		prev, cur := headers[i-1], headers[i]
+		if cur.Height != prev.Height+1 {
+			return fmt.Errorf("verification: header %d: %w (want height %d, got %d)", i, ErrNonConsecutiveHeight, prev.Height+1, cur.Height)
+		}
+		if cur.Timestamp < prev.Timestamp {
+			return fmt.Errorf("verification: header %d: %w (previous %d, got %d)", i, ErrNonMonotonicTimestamp, prev.Timestamp, cur.Timestamp)
+		}
+	}
+	return nil
+}
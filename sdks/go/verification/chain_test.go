@@ -0,0 +1,39 @@
+package verification
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestVerifyHeaderChainAcceptsConsecutiveHeaders(t *testing.T) {
+	headers := []types.HeaderImpl{
+		{Height: 10, Timestamp: 100},
+		{Height: 11, Timestamp: 100},
+		{Height: 12, Timestamp: 105},
+	}
+	if err := VerifyHeaderChain(headers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestVerifyHeaderChainRejectsHeightGap(t *testing.T) {
+	headers := []types.HeaderImpl{
+		{Height: 10, Timestamp: 100},
+		{Height: 12, Timestamp: 100},
+	}
+	if err := VerifyHeaderChain(headers); !errors.Is(err, ErrNonConsecutiveHeight) {
+		t.Fatalf("got %v, want ErrNonConsecutiveHeight", err)
+	}
+}
+
+func TestVerifyHeaderChainRejectsTimestampRegression(t *testing.T) {
+	headers := []types.HeaderImpl{
+		{Height: 10, Timestamp: 100},
+		{Height: 11, Timestamp: 99},
+	}
+	if err := VerifyHeaderChain(headers); !errors.Is(err, ErrNonMonotonicTimestamp) {
+		t.Fatalf("got %v, want ErrNonMonotonicTimestamp", err)
+	}
+}
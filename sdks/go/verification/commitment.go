@@ -0,0 +1,85 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#cgo windows LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#include <stddef.h>
+
+// compute_payload_commitment is implemented in the Rust crypto-helper
+// crate and exported via cbindgen. It writes the string-encoded VID
+// payload commitment for payload, computed under vid_common_json's
+// parameters, into the caller-allocated out_commitment buffer and its
+// length into *out_commitment_len. Returns codeValid (see errors.go) on
+// success, or codeMalformedInput if out_commitment is too small for the
+// computed commitment.
+extern int compute_payload_commitment(
+	const unsigned char *payload, size_t payload_len,
+	const unsigned char *vid_common_json, size_t vid_common_len,
+	unsigned char *out_commitment, size_t out_commitment_cap, size_t *out_commitment_len
+);
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ComputePayloadCommitment computes the VID payload commitment for a raw
+// block payload under vidCommonJSON's parameters, the same way a query
+// service would when it builds a header. Integrators fetching a payload
+// and a header separately can compare the result against
+// header.PayloadCommitment to confirm the payload they got is the one the
+// header actually commits to, before trusting its contents.
+//
+// See VerifyNamespace's doc comment for what ctx cancellation does and
+// doesn't interrupt.
+func ComputePayloadCommitment(ctx context.Context, payload, vidCommonJSON []byte) (types.Commitment, error) {
+	if libraryVersionErr != nil {
+		return "", libraryVersionErr
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if len(payload) == 0 {
+		return "", fmt.Errorf("verification: payload is empty")
+	}
+	if len(payload) > maxInputSize {
+		return "", fmt.Errorf("verification: payload is %d bytes, exceeds %d byte limit", len(payload), maxInputSize)
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return "", err
+	}
+
+	type outcome struct {
+		commitment types.Commitment
+		err        error
+	}
+	result := make(chan outcome, 1)
+	go func() {
+		outBuf := make([]byte, commitmentBufCap)
+		var outLen C.size_t
+		ret := C.compute_payload_commitment(
+			(*C.uchar)(unsafe.Pointer(&payload[0])), C.size_t(len(payload)),
+			(*C.uchar)(unsafe.Pointer(&vidCommonJSON[0])), C.size_t(len(vidCommonJSON)),
+			(*C.uchar)(unsafe.Pointer(&outBuf[0])), C.size_t(len(outBuf)), &outLen,
+		)
+		if err := interpretResult(int32(ret)); err != nil {
+			result <- outcome{err: err}
+			return
+		}
+		result <- outcome{commitment: types.Commitment(outBuf[:outLen])}
+	}()
+
+	select {
+	case r := <-result:
+		return r.commitment, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
@@ -0,0 +1,39 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ComputePayloadCommitment is the embedded-library counterpart to the
+// cgo-linked commitment computation; see its doc comment for the shape of
+// the inputs and output.
+func ComputePayloadCommitment(ctx context.Context, payload, vidCommonJSON []byte) (types.Commitment, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if len(payload) == 0 {
+		return "", fmt.Errorf("verification: payload is empty")
+	}
+	if len(payload) > maxInputSize {
+		return "", fmt.Errorf("verification: payload is %d bytes, exceeds %d byte limit", len(payload), maxInputSize)
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return "", err
+	}
+	if err := loadEmbedded(); err != nil {
+		return "", err
+	}
+
+	outBuf := make([]byte, commitmentBufCap)
+	var outLen uintptr
+	ret := computePayloadCommitment(&payload[0], &vidCommonJSON[0], uintptr(len(payload)), uintptr(len(vidCommonJSON)), &outBuf[0], uintptr(len(outBuf)), &outLen)
+	if err := interpretResult(ret); err != nil {
+		return "", err
+	}
+	return types.Commitment(outBuf[:outLen]), nil
+}
@@ -0,0 +1,17 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ComputePayloadCommitment always returns ErrGenerationUnavailable under
+// the cgo-free build; see its doc comment on GenerateNamespaceProof.
+// Computing a VID commitment needs the same native hashing that generating
+// or verifying a proof does.
+func ComputePayloadCommitment(ctx context.Context, payload, vidCommonJSON []byte) (types.Commitment, error) {
+	return "", ErrGenerationUnavailable
+}
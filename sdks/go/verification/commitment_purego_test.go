@@ -0,0 +1,17 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"testing"
+)
+
+func TestComputePayloadCommitmentUnavailableWithoutNativeLibrary(t *testing.T) {
+	vidCommon := []byte(`"AQ=="`)
+
+	_, err := ComputePayloadCommitment(context.Background(), []byte("payload"), vidCommon)
+	if err != ErrGenerationUnavailable {
+		t.Fatalf("got %v, want ErrGenerationUnavailable", err)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// TestVerifyNamespaceConcurrentStress calls VerifyNamespace from hundreds
+// of goroutines at once, on both a cancelable and a non-cancelable
+// context, to exercise the pooled-channel and direct-call paths under
+// concurrent load. Run with -race: a failure here means the Go bindings
+// introduced a data race, not that the native library itself is
+// non-reentrant (see the package doc comment in verify.go for why that's
+// assumed safe).
+func TestVerifyNamespaceConcurrentStress(t *testing.T) {
+	header := []byte(`{"height":1,"ns_table":"AA==","block_merkle_tree_root":"root"}`)
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[]}`)
+
+	const goroutines = 200
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func(i int) {
+			defer wg.Done()
+			ctx := context.Background()
+			if i%2 == 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(ctx)
+				defer cancel()
+			}
+			_ = VerifyNamespace(ctx, header, vidCommon, proof)
+		}(i)
+	}
+	wg.Wait()
+}
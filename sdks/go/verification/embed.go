@@ -0,0 +1,181 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/ebitengine/purego"
+)
+
+//go:embed lib/*
+var embeddedLibs embed.FS
+
+// ErrNativeLibraryMissing is returned when the embedded build was compiled
+// without a libespresso_crypto_helper binary for the running platform, e.g.
+// because the release pipeline hasn't published one yet.
+var ErrNativeLibraryMissing = errors.New("verification: no embedded native library for this platform")
+
+// libraryPathEnvVar, when set, tells loadEmbedded to dlopen the native
+// library from that path directly instead of extracting this binary's own
+// embedded copy. Useful when a build system - vendoring, Bazel - already
+// places the library somewhere this package has no way to discover on its
+// own the way it discovers a normal Go module's embedded assets.
+const libraryPathEnvVar = "ESPRESSO_CRYPTO_LIB_PATH"
+
+var (
+	libraryPathMu       sync.Mutex
+	libraryPathOverride string
+)
+
+// SetLibraryPath overrides where loadEmbedded looks for the native
+// library, taking precedence over both the ESPRESSO_CRYPTO_LIB_PATH
+// environment variable and the binary's own embedded copy. It must be
+// called before the first verification call in this process: loadEmbedded
+// resolves and dlopen's a library only once, guarded by a sync.Once, and
+// ignores this override afterward.
+func SetLibraryPath(path string) {
+	libraryPathMu.Lock()
+	defer libraryPathMu.Unlock()
+	libraryPathOverride = path
+}
+
+// libraryPathOverridden returns the caller-supplied library path to use
+// instead of the embedded copy, checking the programmatic override before
+// falling back to libraryPathEnvVar, or "" if neither is set.
+func libraryPathOverridden() string {
+	libraryPathMu.Lock()
+	override := libraryPathOverride
+	libraryPathMu.Unlock()
+	if override != "" {
+		return override
+	}
+	return os.Getenv(libraryPathEnvVar)
+}
+
+type verifyNamespaceProofFunc func(headerJSON, vidCommonJSON, proofJSON *byte, headerLen, vidCommonLen, proofLen uintptr, outDiagnostics *byte, outDiagnosticsCap uintptr, outDiagnosticsLen *uintptr) int32
+type verifyBlockMerkleProofFunc func(headerJSON, proofJSON *byte, headerLen, proofLen uintptr, outDiagnostics *byte, outDiagnosticsCap uintptr, outDiagnosticsLen *uintptr) int32
+type verifyRewardMerkleProofFunc func(headerJSON, proofJSON *byte, headerLen, proofLen uintptr, outDiagnostics *byte, outDiagnosticsCap uintptr, outDiagnosticsLen *uintptr) int32
+type generateNamespaceProofFunc func(payload, nsTableJSON *byte, payloadLen, nsTableLen uintptr, namespace uint64, vidCommonJSON *byte, vidCommonLen uintptr, outProof *uintptr, outLen *uintptr) int32
+type freeNamespaceProofBufferFunc func(ptr uintptr, length uintptr)
+type buildBlockMerkleProofFunc func(leavesJSON *byte, leavesLen uintptr, startHeight, targetHeight uint64, outProof *uintptr, outLen *uintptr) int32
+type freeBlockMerkleProofBufferFunc func(ptr uintptr, length uintptr)
+type computePayloadCommitmentFunc func(payload, vidCommonJSON *byte, payloadLen, vidCommonLen uintptr, outCommitment *byte, outCommitmentCap uintptr, outCommitmentLen *uintptr) int32
+type cryptoHelperVersionFunc func() string
+type verifyLightClientStateSignatureFunc func(stateJSON, signaturesJSON *byte, stateLen, signaturesLen uintptr, outDiagnostics *byte, outDiagnosticsCap uintptr, outDiagnosticsLen *uintptr) int32
+
+var (
+	loadOnce                        sync.Once
+	loadErr                         error
+	verifyNamespaceProof            verifyNamespaceProofFunc
+	verifyBlockMerkleProof          verifyBlockMerkleProofFunc
+	verifyRewardMerkleProof         verifyRewardMerkleProofFunc
+	generateNamespaceProof          generateNamespaceProofFunc
+	freeNamespaceProofBuffer        freeNamespaceProofBufferFunc
+	buildBlockMerkleProof           buildBlockMerkleProofFunc
+	freeBlockMerkleProofBuffer      freeBlockMerkleProofBufferFunc
+	computePayloadCommitment        computePayloadCommitmentFunc
+	cryptoHelperVersion             cryptoHelperVersionFunc
+	verifyLightClientStateSignature verifyLightClientStateSignatureFunc
+)
+
+// embeddedLibName returns the embedded filename for the running platform,
+// matching the naming convention documented in lib/README.md.
+func embeddedLibName() (string, error) {
+	switch runtime.GOOS {
+	case "linux":
+		return fmt.Sprintf("libespresso_crypto_helper_linux_%s.so", runtime.GOARCH), nil
+	case "darwin":
+		return fmt.Sprintf("libespresso_crypto_helper_darwin_%s.dylib", runtime.GOARCH), nil
+	case "windows":
+		return fmt.Sprintf("espresso_crypto_helper_windows_%s.dll", runtime.GOARCH), nil
+	default:
+		return "", fmt.Errorf("verification: unsupported platform %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+}
+
+// registerLibFuncs binds every native function this package calls against
+// the library at handle, and checks its version. Shared by both of
+// loadEmbedded's paths: dlopen-by-override and extract-then-dlopen.
+func registerLibFuncs(handle uintptr) error {
+	purego.RegisterLibFunc(&verifyNamespaceProof, handle, "verify_namespace_proof")
+	purego.RegisterLibFunc(&verifyBlockMerkleProof, handle, "verify_block_merkle_proof")
+	purego.RegisterLibFunc(&verifyRewardMerkleProof, handle, "verify_reward_merkle_proof")
+	purego.RegisterLibFunc(&generateNamespaceProof, handle, "generate_namespace_proof")
+	purego.RegisterLibFunc(&freeNamespaceProofBuffer, handle, "free_namespace_proof_buffer")
+	purego.RegisterLibFunc(&buildBlockMerkleProof, handle, "build_block_merkle_proof")
+	purego.RegisterLibFunc(&freeBlockMerkleProofBuffer, handle, "free_block_merkle_proof_buffer")
+	purego.RegisterLibFunc(&computePayloadCommitment, handle, "compute_payload_commitment")
+	purego.RegisterLibFunc(&cryptoHelperVersion, handle, "crypto_helper_version")
+	purego.RegisterLibFunc(&verifyLightClientStateSignature, handle, "verify_light_client_state_signature")
+	purego.RegisterLibFunc(&verifyNamespaceProofFromFile, handle, "verify_namespace_proof_from_file")
+	return checkLibraryVersion(cryptoHelperVersion())
+}
+
+// loadEmbedded locates and dlopen's the native library, so consumers get a
+// working verifier from `go build` alone instead of a separate
+// download/symlink step. It dlopen's SetLibraryPath's or
+// ESPRESSO_CRYPTO_LIB_PATH's path directly if either is set; otherwise it
+// extracts the embedded copy for the running platform into the user cache
+// directory first.
+func loadEmbedded() error {
+	loadOnce.Do(func() {
+		if path := libraryPathOverridden(); path != "" {
+			handle, err := purego.Dlopen(path, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+			if err != nil {
+				loadErr = fmt.Errorf("verification: dlopen %s: %w", path, err)
+				return
+			}
+			loadErr = registerLibFuncs(handle)
+			return
+		}
+
+		name, err := embeddedLibName()
+		if err != nil {
+			loadErr = err
+			return
+		}
+
+		data, err := embeddedLibs.ReadFile(filepath.Join("lib", name))
+		if errors.Is(err, os.ErrNotExist) {
+			loadErr = ErrNativeLibraryMissing
+			return
+		}
+		if err != nil {
+			loadErr = fmt.Errorf("verification: read embedded library: %w", err)
+			return
+		}
+
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			loadErr = fmt.Errorf("verification: locate cache dir: %w", err)
+			return
+		}
+		dir := filepath.Join(cacheDir, "espresso-go-sdk")
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			loadErr = fmt.Errorf("verification: create cache dir: %w", err)
+			return
+		}
+
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0o755); err != nil {
+			loadErr = fmt.Errorf("verification: extract native library: %w", err)
+			return
+		}
+
+		handle, err := purego.Dlopen(path, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		if err != nil {
+			loadErr = fmt.Errorf("verification: dlopen %s: %w", path, err)
+			return
+		}
+
+		loadErr = registerLibFuncs(handle)
+	})
+	return loadErr
+}
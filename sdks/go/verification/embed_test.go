@@ -0,0 +1,24 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import "testing"
+
+func TestLibraryPathOverriddenPrefersProgrammaticOverEnv(t *testing.T) {
+	t.Setenv(libraryPathEnvVar, "/from/env")
+	SetLibraryPath("/from/code")
+	defer SetLibraryPath("")
+
+	if got := libraryPathOverridden(); got != "/from/code" {
+		t.Fatalf("got %q, want /from/code", got)
+	}
+}
+
+func TestLibraryPathOverriddenFallsBackToEnv(t *testing.T) {
+	t.Setenv(libraryPathEnvVar, "/from/env")
+	SetLibraryPath("")
+
+	if got := libraryPathOverridden(); got != "/from/env" {
+		t.Fatalf("got %q, want /from/env", got)
+	}
+}
@@ -0,0 +1,111 @@
+package verification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Result codes returned by the native verifier's FFI functions, alongside
+// the batch verifier's per-entry results. 1 still means "valid" so existing
+// callers checking `ret == 1` keep working; the old blanket "invalid"
+// value 0 is replaced by specific negative codes so Go can surface why a
+// proof was rejected instead of callers grepping error strings.
+const (
+	codeValid              = 1
+	codeNamespaceMismatch  = -1
+	codeCommitmentMismatch = -2
+	codeMalformedProof     = -3
+	codeMalformedInput     = -4
+)
+
+// ErrInvalidProof is returned when the native verifier rejects a proof for
+// a reason that doesn't map to one of the more specific errors below.
+var ErrInvalidProof = errors.New("verification: proof is invalid")
+
+// ErrNamespaceMismatch is returned when a namespace proof's namespace does
+// not match the namespace it was checked against.
+var ErrNamespaceMismatch = errors.New("verification: namespace proof does not match the claimed namespace")
+
+// ErrCommitmentMismatch is returned when a proof's computed root does not
+// match the commitment embedded in the header it was checked against.
+var ErrCommitmentMismatch = errors.New("verification: proof's root does not match the header's commitment")
+
+// ErrMalformedProof is returned when a proof's structure itself is invalid
+// (e.g. a merkle path of the wrong length), as distinct from a
+// well-formed proof that simply doesn't match.
+var ErrMalformedProof = errors.New("verification: proof is malformed")
+
+// ErrEpochFieldsMissing is returned by VerifyRewardAccountProof when the
+// header it's checking against predates HeaderVersionV3 and so has no
+// RewardMerkleRoot to compare the proof's commitment against.
+var ErrEpochFieldsMissing = errors.New("verification: header has no epoch fields to verify a reward proof against")
+
+// VerificationError adds the detail a bare sentinel error can't carry: which
+// stage of verification failed (e.g. "ns_table_lookup", "vid_check",
+// "commitment_comparison", "merkle_path") and, when the failure was a
+// mismatch, the commitment values that didn't match. "circuit commitment
+// mismatch" alone doesn't tell an on-call engineer whether the header, the
+// VID common data, or the caller's expected commitment was the stale one;
+// Expected/Actual do. Err is one of the sentinel errors in this file, except
+// when the native verifier reports codeMalformedInput, which interpretResult
+// maps to a plain non-sentinel error; use errors.Is or errors.As against it
+// rather than comparing a VerificationError by value.
+type VerificationError struct {
+	Stage    string
+	Expected string
+	Actual   string
+	Err      error
+}
+
+func (e *VerificationError) Error() string {
+	if e.Expected == "" && e.Actual == "" {
+		return fmt.Sprintf("%s (stage: %s)", e.Err, e.Stage)
+	}
+	return fmt.Sprintf("%s (stage: %s, expected %q, got %q)", e.Err, e.Stage, e.Expected, e.Actual)
+}
+
+func (e *VerificationError) Unwrap() error { return e.Err }
+
+// diagnostics is the wire shape the native verifier writes to an
+// out_diagnostics buffer on failure, decoded by withDiagnostics.
+type diagnostics struct {
+	Stage    string `json:"stage"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// withDiagnostics wraps a non-nil err in a *VerificationError using the
+// stage/expected/actual fields encoded in diagnosticsJSON. A native
+// verifier that didn't write diagnostics (diagnosticsJSON empty or
+// malformed) still gets wrapped, just with an empty Stage - a missing
+// diagnostic shouldn't turn a real verification failure into a confusing
+// decode error instead.
+func withDiagnostics(err error, diagnosticsJSON []byte) error {
+	if err == nil {
+		return nil
+	}
+	var d diagnostics
+	_ = json.Unmarshal(diagnosticsJSON, &d)
+	return &VerificationError{Stage: d.Stage, Expected: d.Expected, Actual: d.Actual, Err: err}
+}
+
+// interpretResult maps a native verifier result code to a Go error, or nil
+// for a valid proof. It is shared by the cgo and embedded backends, which
+// both call into the same crypto-helper FFI contract.
+func interpretResult(ret int32) error {
+	switch ret {
+	case codeValid:
+		return nil
+	case codeNamespaceMismatch:
+		return ErrNamespaceMismatch
+	case codeCommitmentMismatch:
+		return ErrCommitmentMismatch
+	case codeMalformedProof:
+		return ErrMalformedProof
+	case codeMalformedInput:
+		return errors.New("verification: malformed input rejected by native verifier")
+	default:
+		return ErrInvalidProof
+	}
+}
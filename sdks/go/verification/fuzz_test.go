@@ -0,0 +1,45 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// FuzzVerifyNamespace feeds arbitrary, almost-certainly-malformed bytes
+// into VerifyNamespace. It doesn't assert anything about the result - most
+// inputs aren't even valid JSON - only that the call returns an error
+// instead of panicking or hanging, since a crash here would take down
+// whatever rollup node linked this package.
+func FuzzVerifyNamespace(f *testing.F) {
+	f.Add([]byte(`{"height":1}`), []byte(`"AQ=="`), []byte(`{}`))
+	f.Add([]byte(``), []byte(``), []byte(``))
+	f.Add([]byte(`not json`), []byte(`{`), []byte(`]`))
+
+	f.Fuzz(func(t *testing.T, headerJSON, vidCommonJSON, proofJSON []byte) {
+		_ = VerifyNamespace(context.Background(), headerJSON, vidCommonJSON, proofJSON)
+	})
+}
+
+// FuzzVerifyMerkleProof feeds arbitrary bytes, decoded as the header and
+// proof JSON that would normally come from a query service, into
+// VerifyMerkleProof. See FuzzVerifyNamespace's doc comment for what this
+// does and doesn't check.
+func FuzzVerifyMerkleProof(f *testing.F) {
+	f.Add([]byte(`{"block_merkle_tree_root":"root"}`), []byte(`{}`), "root")
+	f.Add([]byte(``), []byte(``), "")
+
+	f.Fuzz(func(t *testing.T, headerJSON, proofJSON []byte, commitment string) {
+		var header types.HeaderImpl
+		if err := json.Unmarshal(headerJSON, &header); err != nil {
+			return
+		}
+		var proof types.BlockMerkleProof
+		if err := json.Unmarshal(proofJSON, &proof); err != nil {
+			return
+		}
+		_ = VerifyMerkleProof(context.Background(), header, proof, types.Commitment(commitment))
+	})
+}
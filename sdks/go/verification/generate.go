@@ -0,0 +1,105 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#cgo windows LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#include <stddef.h>
+#include <stdlib.h>
+
+// generate_namespace_proof is implemented in the Rust crypto-helper crate
+// and exported via cbindgen. On success it returns codeValid (see
+// errors.go), allocates *out_proof with the Rust global allocator, and
+// writes its length to *out_len; the caller must pass that buffer to
+// free_namespace_proof_buffer exactly once to release it. On failure
+// *out_proof and *out_len are left untouched and the return value is one
+// of the other codeXxx constants describing why generation failed (e.g.
+// namespace isn't present in ns_table_json).
+extern int generate_namespace_proof(
+	const unsigned char *payload, size_t payload_len,
+	const unsigned char *ns_table_json, size_t ns_table_len,
+	unsigned long long namespace,
+	const unsigned char *vid_common_json, size_t vid_common_len,
+	unsigned char **out_proof, size_t *out_len
+);
+
+// free_namespace_proof_buffer releases a buffer allocated by
+// generate_namespace_proof. It must be called with the same pointer and
+// length generate_namespace_proof returned, and exactly once.
+extern void free_namespace_proof_buffer(unsigned char *ptr, size_t len);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// GenerateNamespaceProof builds a namespace proof for namespace from a raw
+// block payload, the block header's ns_table, and its VID common data,
+// returning the same byte-exact proof JSON shape VerifyNamespace expects.
+// It exists so Go services - custom builders, test harnesses - can produce
+// fixtures and proofs without standing up a whole sequencer network to get
+// one.
+//
+// payload is the raw, undecoded block payload; nsTableJSON and
+// vidCommonJSON are the byte-exact JSON documents a query service would
+// return for the block's ns_table and VID common (e.g. via
+// client.FetchRawVidCommonByHeight). See VerifyNamespace's doc comment for
+// what ctx cancellation does and doesn't interrupt.
+func GenerateNamespaceProof(ctx context.Context, payload, nsTableJSON, vidCommonJSON []byte, namespace types.NamespaceId) (json.RawMessage, error) {
+	if libraryVersionErr != nil {
+		return nil, libraryVersionErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("verification: payload is empty")
+	}
+	if len(payload) > maxInputSize {
+		return nil, fmt.Errorf("verification: payload is %d bytes, exceeds %d byte limit", len(payload), maxInputSize)
+	}
+	if err := validateJSONInput("ns_table", nsTableJSON); err != nil {
+		return nil, err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return nil, err
+	}
+
+	type outcome struct {
+		proof json.RawMessage
+		err   error
+	}
+	result := make(chan outcome, 1)
+	go func() {
+		var outPtr *C.uchar
+		var outLen C.size_t
+		ret := C.generate_namespace_proof(
+			(*C.uchar)(unsafe.Pointer(&payload[0])), C.size_t(len(payload)),
+			(*C.uchar)(unsafe.Pointer(&nsTableJSON[0])), C.size_t(len(nsTableJSON)),
+			C.ulonglong(uint64(namespace)),
+			(*C.uchar)(unsafe.Pointer(&vidCommonJSON[0])), C.size_t(len(vidCommonJSON)),
+			&outPtr, &outLen,
+		)
+		if err := interpretResult(int32(ret)); err != nil {
+			result <- outcome{err: err}
+			return
+		}
+		proof := C.GoBytes(unsafe.Pointer(outPtr), C.int(outLen))
+		C.free_namespace_proof_buffer(outPtr, outLen)
+		result <- outcome{proof: proof}
+	}()
+
+	select {
+	case r := <-result:
+		return r.proof, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,53 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// GenerateNamespaceProof is the embedded-library counterpart to the
+// cgo-linked proof generator; see its doc comment for the shape of the
+// inputs and output.
+func GenerateNamespaceProof(ctx context.Context, payload, nsTableJSON, vidCommonJSON []byte, namespace types.NamespaceId) (json.RawMessage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("verification: payload is empty")
+	}
+	if len(payload) > maxInputSize {
+		return nil, fmt.Errorf("verification: payload is %d bytes, exceeds %d byte limit", len(payload), maxInputSize)
+	}
+	if err := validateJSONInput("ns_table", nsTableJSON); err != nil {
+		return nil, err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return nil, err
+	}
+	if err := loadEmbedded(); err != nil {
+		return nil, err
+	}
+
+	var outPtr, outLen uintptr
+	ret := generateNamespaceProof(
+		&payload[0], &nsTableJSON[0],
+		uintptr(len(payload)), uintptr(len(nsTableJSON)),
+		uint64(namespace),
+		&vidCommonJSON[0], uintptr(len(vidCommonJSON)),
+		&outPtr, &outLen,
+	)
+	if err := interpretResult(ret); err != nil {
+		return nil, err
+	}
+
+	proof := make([]byte, outLen)
+	copy(proof, unsafe.Slice((*byte)(unsafe.Pointer(outPtr)), outLen))
+	freeNamespaceProofBuffer(outPtr, outLen)
+	return proof, nil
+}
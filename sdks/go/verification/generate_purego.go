@@ -0,0 +1,24 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ErrGenerationUnavailable is returned by functions that construct proofs
+// or commitments under the cgo-free build. Unlike verification, these need
+// the native crypto-helper's hashing and merkle path construction; there's
+// no structural check to fall back to the way VerifyNamespace has one.
+var ErrGenerationUnavailable = errors.New("verification: proof or commitment construction requires the cgo or embedded build")
+
+// GenerateNamespaceProof always returns ErrGenerationUnavailable under the
+// cgo-free build. Build with cgo, or use the embedded build, to generate
+// proofs.
+func GenerateNamespaceProof(ctx context.Context, payload, nsTableJSON, vidCommonJSON []byte, namespace types.NamespaceId) (json.RawMessage, error) {
+	return nil, ErrGenerationUnavailable
+}
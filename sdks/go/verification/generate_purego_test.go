@@ -0,0 +1,18 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGenerateNamespaceProofUnavailableWithoutNativeLibrary(t *testing.T) {
+	header := []byte(`{"height":1}`)
+	vidCommon := []byte(`"AQ=="`)
+
+	_, err := GenerateNamespaceProof(context.Background(), []byte("payload"), header, vidCommon, 1)
+	if err != ErrGenerationUnavailable {
+		t.Fatalf("got %v, want ErrGenerationUnavailable", err)
+	}
+}
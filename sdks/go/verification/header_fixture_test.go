@@ -0,0 +1,23 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import "fmt"
+
+// completeHeaderJSON builds a headerV0Fields-complete header with the given
+// ns_table, for tests that need HeaderImpl.UnmarshalJSON to actually decode
+// into HeaderVersionV0 rather than falling back to HeaderVersionUnknown -
+// see header.go's headerV0Fields doc comment for why partial headers like
+// {"height":1} aren't enough for that.
+func completeHeaderJSON(nsTable string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"height": 1,
+		"timestamp": 1,
+		"l1_head": 1,
+		"payload_commitment": "PAYLOAD~AAA",
+		"builder_commitment": "BUILDER~AAA",
+		"ns_table": %q,
+		"block_merkle_tree_root": "BLOCK~AAA",
+		"fee_merkle_tree_root": "FEE~AAA"
+	}`, nsTable))
+}
@@ -0,0 +1,82 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#include <stddef.h>
+
+// verify_light_client_state_signature is implemented in the Rust
+// crypto-helper crate. It checks that signatures, a JSON array of
+// {"key":..,"signature":..} objects (StateSignature's wire shape), form a
+// valid quorum of BLS signatures over state_json under the stake table
+// committed to by state's own stake_table_*_comm fields, matching the
+// threshold check the light client contract performs on L1. Returns 1 for
+// a valid quorum and one of the negative codeXxx constants in errors.go
+// otherwise, writing stage/expected/actual diagnostics the same way
+// verify_namespace_proof does (see that function's comment in verify.go).
+extern int verify_light_client_state_signature(
+	const unsigned char *state_json, size_t state_len,
+	const unsigned char *signatures_json, size_t signatures_len,
+	unsigned char *out_diagnostics, size_t out_diagnostics_cap, size_t *out_diagnostics_len
+);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// VerifyLightClientStateSignature checks that signatures form a valid
+// quorum of the stake table's BLS signatures over state, the same check
+// the light client contract performs before accepting a state update on
+// L1. It lets a Go service accept a HotShot state update as a fast,
+// pre-finalization confirmation - e.g. to unblock a withdrawal's UI state -
+// without waiting for that update to actually land on L1, by checking the
+// same signature the contract will eventually check itself.
+//
+// See VerifyNamespace's doc comment for what ctx cancellation does and
+// doesn't interrupt.
+func VerifyLightClientStateSignature(ctx context.Context, state types.LightClientState, signatures []types.StateSignature) error {
+	if libraryVersionErr != nil {
+		return libraryVersionErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(signatures) == 0 {
+		return fmt.Errorf("verification: no signatures provided")
+	}
+
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("verification: encode state: %w", err)
+	}
+	signaturesJSON, err := json.Marshal(signatures)
+	if err != nil {
+		return fmt.Errorf("verification: encode signatures: %w", err)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		diagnosticsBuf := make([]byte, diagnosticsBufCap)
+		var diagnosticsLen C.size_t
+		ret := C.verify_light_client_state_signature(
+			(*C.uchar)(unsafe.Pointer(&stateJSON[0])), C.size_t(len(stateJSON)),
+			(*C.uchar)(unsafe.Pointer(&signaturesJSON[0])), C.size_t(len(signaturesJSON)),
+			(*C.uchar)(unsafe.Pointer(&diagnosticsBuf[0])), C.size_t(len(diagnosticsBuf)), &diagnosticsLen,
+		)
+		result <- withDiagnostics(interpretResult(int32(ret)), diagnosticsBuf[:diagnosticsLen])
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,42 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// VerifyLightClientStateSignature is the embedded-library counterpart to
+// the cgo-linked light client signature verifier. See its doc comment.
+func VerifyLightClientStateSignature(ctx context.Context, state types.LightClientState, signatures []types.StateSignature) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(signatures) == 0 {
+		return fmt.Errorf("verification: no signatures provided")
+	}
+	if err := loadEmbedded(); err != nil {
+		return err
+	}
+
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("verification: encode state: %w", err)
+	}
+	signaturesJSON, err := json.Marshal(signatures)
+	if err != nil {
+		return fmt.Errorf("verification: encode signatures: %w", err)
+	}
+
+	diagnosticsBuf := make([]byte, diagnosticsBufCap)
+	var diagnosticsLen uintptr
+	ret := verifyLightClientStateSignature(
+		&stateJSON[0], &signaturesJSON[0], uintptr(len(stateJSON)), uintptr(len(signaturesJSON)),
+		&diagnosticsBuf[0], uintptr(len(diagnosticsBuf)), &diagnosticsLen,
+	)
+	return withDiagnostics(interpretResult(ret), diagnosticsBuf[:diagnosticsLen])
+}
@@ -0,0 +1,25 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ErrSignatureVerificationUnavailable is returned by
+// VerifyLightClientStateSignature under the cgo-free build. Unlike
+// VerifyNamespace and VerifyMerkleProof, a BLS quorum signature check has
+// no structural check to approximate it with: a signature is either valid
+// over the stake table or it isn't, so there's nothing short of the real
+// cryptography worth checking.
+var ErrSignatureVerificationUnavailable = errors.New("verification: light client signature verification requires the cgo or embedded build")
+
+// VerifyLightClientStateSignature always returns
+// ErrSignatureVerificationUnavailable under the cgo-free build. Build with
+// cgo, or use the embedded build, to verify light client signatures.
+func VerifyLightClientStateSignature(ctx context.Context, state types.LightClientState, signatures []types.StateSignature) error {
+	return ErrSignatureVerificationUnavailable
+}
@@ -0,0 +1,21 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestVerifyLightClientStateSignatureUnavailable(t *testing.T) {
+	state := types.LightClientState{ViewNumber: 1, BlockHeight: 1}
+	signatures := []types.StateSignature{{Key: "k", Signature: "s"}}
+
+	err := VerifyLightClientStateSignature(context.Background(), state, signatures)
+	if !errors.Is(err, ErrSignatureVerificationUnavailable) {
+		t.Fatalf("got %v, want ErrSignatureVerificationUnavailable", err)
+	}
+}
@@ -0,0 +1,105 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#cgo windows LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#include <stddef.h>
+#include <stdlib.h>
+
+// build_block_merkle_proof is implemented in the Rust crypto-helper crate
+// and exported via cbindgen. leaves_json is a JSON array of the
+// string-encoded leaf commitments for heights
+// [start_height, start_height+len(leaves)), in order; target_height must
+// fall within that range. On success it returns codeValid (see errors.go),
+// allocates *out_proof with the Rust global allocator holding the JSON
+// encoding of a BlockMerkleProof, and writes its length to *out_len; the
+// caller must pass that buffer to free_block_merkle_proof_buffer exactly
+// once. On failure *out_proof and *out_len are left untouched.
+extern int build_block_merkle_proof(
+	const unsigned char *leaves_json, size_t leaves_len,
+	unsigned long long start_height, unsigned long long target_height,
+	unsigned char **out_proof, size_t *out_len
+);
+
+// free_block_merkle_proof_buffer releases a buffer allocated by
+// build_block_merkle_proof. It must be called with the same pointer and
+// length build_block_merkle_proof returned, and exactly once.
+extern void free_block_merkle_proof_buffer(unsigned char *ptr, size_t len);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// BuildBlockMerkleProof constructs a block merkle proof for targetHeight
+// from a contiguous run of leaf commitments, the same way a query node
+// would, without depending on its proof endpoint. leafCommitments[i] must
+// be the leaf commitment for height startHeight+i; targetHeight must fall
+// within that range. This is what archival tooling needs to generate
+// proofs for historical data the original query node may no longer serve.
+//
+// See VerifyNamespace's doc comment for what ctx cancellation does and
+// doesn't interrupt.
+func BuildBlockMerkleProof(ctx context.Context, leafCommitments []types.Commitment, startHeight, targetHeight uint64) (types.BlockMerkleProof, error) {
+	if libraryVersionErr != nil {
+		return types.BlockMerkleProof{}, libraryVersionErr
+	}
+	if err := ctx.Err(); err != nil {
+		return types.BlockMerkleProof{}, err
+	}
+	if len(leafCommitments) == 0 {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: no leaf commitments supplied")
+	}
+	if targetHeight < startHeight || targetHeight >= startHeight+uint64(len(leafCommitments)) {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: target height %d outside supplied range [%d, %d)",
+			targetHeight, startHeight, startHeight+uint64(len(leafCommitments)))
+	}
+
+	leavesJSON, err := json.Marshal(leafCommitments)
+	if err != nil {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: encode leaf commitments: %w", err)
+	}
+
+	type outcome struct {
+		proof types.BlockMerkleProof
+		err   error
+	}
+	result := make(chan outcome, 1)
+	go func() {
+		var outPtr *C.uchar
+		var outLen C.size_t
+		ret := C.build_block_merkle_proof(
+			(*C.uchar)(unsafe.Pointer(&leavesJSON[0])), C.size_t(len(leavesJSON)),
+			C.ulonglong(startHeight), C.ulonglong(targetHeight),
+			&outPtr, &outLen,
+		)
+		if err := interpretResult(int32(ret)); err != nil {
+			result <- outcome{err: err}
+			return
+		}
+		proofJSON := C.GoBytes(unsafe.Pointer(outPtr), C.int(outLen))
+		C.free_block_merkle_proof_buffer(outPtr, outLen)
+
+		var proof types.BlockMerkleProof
+		if err := json.Unmarshal(proofJSON, &proof); err != nil {
+			result <- outcome{err: fmt.Errorf("verification: decode constructed proof: %w", err)}
+			return
+		}
+		result <- outcome{proof: proof}
+	}()
+
+	select {
+	case r := <-result:
+		return r.proof, r.err
+	case <-ctx.Done():
+		return types.BlockMerkleProof{}, ctx.Err()
+	}
+}
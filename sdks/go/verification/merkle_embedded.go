@@ -0,0 +1,52 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// BuildBlockMerkleProof is the embedded-library counterpart to the
+// cgo-linked proof builder; see its doc comment for the shape of the
+// inputs and output.
+func BuildBlockMerkleProof(ctx context.Context, leafCommitments []types.Commitment, startHeight, targetHeight uint64) (types.BlockMerkleProof, error) {
+	if err := ctx.Err(); err != nil {
+		return types.BlockMerkleProof{}, err
+	}
+	if len(leafCommitments) == 0 {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: no leaf commitments supplied")
+	}
+	if targetHeight < startHeight || targetHeight >= startHeight+uint64(len(leafCommitments)) {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: target height %d outside supplied range [%d, %d)",
+			targetHeight, startHeight, startHeight+uint64(len(leafCommitments)))
+	}
+	if err := loadEmbedded(); err != nil {
+		return types.BlockMerkleProof{}, err
+	}
+
+	leavesJSON, err := json.Marshal(leafCommitments)
+	if err != nil {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: encode leaf commitments: %w", err)
+	}
+
+	var outPtr, outLen uintptr
+	ret := buildBlockMerkleProof(&leavesJSON[0], uintptr(len(leavesJSON)), startHeight, targetHeight, &outPtr, &outLen)
+	if err := interpretResult(ret); err != nil {
+		return types.BlockMerkleProof{}, err
+	}
+
+	proofJSON := make([]byte, outLen)
+	copy(proofJSON, unsafe.Slice((*byte)(unsafe.Pointer(outPtr)), outLen))
+	freeBlockMerkleProofBuffer(outPtr, outLen)
+
+	var proof types.BlockMerkleProof
+	if err := json.Unmarshal(proofJSON, &proof); err != nil {
+		return types.BlockMerkleProof{}, fmt.Errorf("verification: decode constructed proof: %w", err)
+	}
+	return proof, nil
+}
@@ -0,0 +1,17 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// BuildBlockMerkleProof always returns ErrGenerationUnavailable under the
+// cgo-free build; see its doc comment on GenerateNamespaceProof. Building a
+// real merkle proof needs the native crypto-helper's tree construction the
+// same way generating a namespace proof does.
+func BuildBlockMerkleProof(ctx context.Context, leafCommitments []types.Commitment, startHeight, targetHeight uint64) (types.BlockMerkleProof, error) {
+	return types.BlockMerkleProof{}, ErrGenerationUnavailable
+}
@@ -0,0 +1,19 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestBuildBlockMerkleProofUnavailableWithoutNativeLibrary(t *testing.T) {
+	leaves := []types.Commitment{"a", "b", "c"}
+
+	_, err := BuildBlockMerkleProof(context.Background(), leaves, 0, 1)
+	if err != ErrGenerationUnavailable {
+		t.Fatalf("got %v, want ErrGenerationUnavailable", err)
+	}
+}
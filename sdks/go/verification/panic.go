@@ -0,0 +1,20 @@
+package verification
+
+import "fmt"
+
+// recoverFFIPanic converts a panic during an FFI call into an error in
+// *dst instead of letting it propagate and take down the whole process -
+// defer it directly (defer recoverFFIPanic(&err)) in the function or
+// goroutine making a call into the native library; called from inside
+// another deferred closure it recovers nothing. It only catches Go-side panics: a bug in
+// this package indexing past a native-returned buffer, for instance. It
+// cannot catch the native crypto-helper aborting or segfaulting across the
+// cgo boundary itself; that's Rust undefined behavior this SDK has no way
+// to intercept, and crypto-helper must be hardened separately to reject
+// malformed input with one of the codeXxx constants instead of panicking
+// across an extern "C" boundary.
+func recoverFFIPanic(dst *error) {
+	if r := recover(); r != nil {
+		*dst = fmt.Errorf("verification: recovered from panic: %v", r)
+	}
+}
@@ -0,0 +1,91 @@
+package verification
+
+import (
+	"context"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// Pool bounds how many verification calls run at once. Each VerifyNamespace
+// or VerifyMerkleProof call under the cgo build spends a goroutine blocked
+// on the native library; an unbounded fan-out (e.g. verifying every proof
+// in a catch-up batch concurrently) can spawn thousands of OS threads and
+// starve the scheduler. Pool caps that to a fixed concurrency while still
+// letting independent verifications overlap on multi-core machines.
+type Pool struct {
+	sem chan struct{}
+}
+
+// NewPool creates a Pool that runs at most concurrency verifications at
+// once. concurrency <= 0 is treated as 1.
+func NewPool(concurrency int) *Pool {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &Pool{sem: make(chan struct{}, concurrency)}
+}
+
+// Future is the result of a verification queued on a Pool. It resolves
+// once the pool has a free slot and the verification has run.
+type Future struct {
+	done chan struct{}
+	err  error
+}
+
+// Wait blocks until the verification completes and returns its error, or
+// returns ctx.Err() if ctx is done first. Waiting does not cancel the
+// underlying verification; see VerifyNamespace's doc comment.
+func (f *Future) Wait(ctx context.Context) error {
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Pool) acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case p.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// VerifyNamespace queues a namespace proof verification on the pool,
+// running it once a slot is free, and returns a Future for its result.
+func (p *Pool) VerifyNamespace(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) *Future {
+	f := &Future{done: make(chan struct{})}
+	if err := p.acquire(ctx); err != nil {
+		f.err = err
+		close(f.done)
+		return f
+	}
+	go func() {
+		defer func() { <-p.sem }()
+		f.err = VerifyNamespace(ctx, headerJSON, vidCommonJSON, proofJSON)
+		close(f.done)
+	}()
+	return f
+}
+
+// VerifyMerkleProof queues a block merkle proof verification on the pool,
+// running it once a slot is free, and returns a Future for its result.
+func (p *Pool) VerifyMerkleProof(ctx context.Context, header types.HeaderImpl, proof types.BlockMerkleProof, commitment types.Commitment) *Future {
+	f := &Future{done: make(chan struct{})}
+	if err := p.acquire(ctx); err != nil {
+		f.err = err
+		close(f.done)
+		return f
+	}
+	go func() {
+		defer func() { <-p.sem }()
+		f.err = VerifyMerkleProof(ctx, header, proof, commitment)
+		close(f.done)
+	}()
+	return f
+}
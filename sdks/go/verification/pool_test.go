@@ -0,0 +1,32 @@
+package verification
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPoolRunsQueuedVerifications(t *testing.T) {
+	pool := NewPool(2)
+	ctx := context.Background()
+
+	futures := make([]*Future, 5)
+	for i := range futures {
+		futures[i] = pool.VerifyNamespace(ctx, nil, nil, nil)
+	}
+	for i, f := range futures {
+		if err := f.Wait(ctx); err == nil {
+			t.Fatalf("future %d: expected error for empty input", i)
+		}
+	}
+}
+
+func TestPoolFutureRespectsWaitContext(t *testing.T) {
+	pool := NewPool(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f := pool.VerifyNamespace(ctx, nil, nil, nil)
+	if err := f.Wait(ctx); err != context.Canceled {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,89 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// VerificationReport is VerifyNamespaceReport's result: a snapshot a
+// monitoring system can record unconditionally, rather than a value it
+// has to unwrap an error to get at. Valid is the only field most callers
+// need; FailedChecks, the size fields, and Duration exist so dashboards
+// can break down why and how expensive verification was without
+// re-running it.
+type VerificationReport struct {
+	Valid bool
+
+	// FailedChecks names the verification stages that rejected the proof
+	// (e.g. "namespace_mismatch", "ns_table_lookup"), empty when Valid is
+	// true. It comes from a *VerificationError's Stage when the failure
+	// carries one, or a generic name derived from the sentinel error
+	// otherwise.
+	FailedChecks []string
+
+	// Err is set only for failures unrelated to the proof's own validity -
+	// malformed input, a cancelled context, a missing native library - the
+	// kind of failure a caller would normally want treated as exceptional.
+	// It is nil whenever Valid is true or FailedChecks is non-empty.
+	Err error
+
+	HeaderSize    int
+	VidCommonSize int
+	ProofSize     int
+	Duration      time.Duration
+}
+
+// VerifyNamespaceReport calls VerifyNamespace and turns its result into a
+// VerificationReport instead of an error, so a monitoring system recording
+// verification outcomes doesn't have to treat an ordinary proof mismatch -
+// the expected outcome for a meaningful fraction of real traffic, e.g. a
+// query node serving a stale proof - as an exceptional code path just to
+// find out why it failed.
+func VerifyNamespaceReport(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) VerificationReport {
+	report := VerificationReport{
+		HeaderSize:    len(headerJSON),
+		VidCommonSize: len(vidCommonJSON),
+		ProofSize:     len(proofJSON),
+	}
+
+	start := time.Now()
+	err := VerifyNamespace(ctx, headerJSON, vidCommonJSON, proofJSON)
+	report.Duration = time.Since(start)
+
+	if err == nil {
+		report.Valid = true
+		return report
+	}
+	if checks, expected := classifyProofError(err); expected {
+		report.FailedChecks = checks
+		return report
+	}
+	report.Err = err
+	return report
+}
+
+// classifyProofError reports whether err is one of the expected "the proof
+// just doesn't check out" outcomes, and if so, the failed-check name(s) to
+// attach to a VerificationReport.
+func classifyProofError(err error) ([]string, bool) {
+	var verr *VerificationError
+	if errors.As(err, &verr) {
+		if verr.Stage != "" {
+			return []string{verr.Stage}, true
+		}
+		err = verr.Err
+	}
+	switch {
+	case errors.Is(err, ErrNamespaceMismatch):
+		return []string{"namespace_mismatch"}, true
+	case errors.Is(err, ErrCommitmentMismatch):
+		return []string{"commitment_mismatch"}, true
+	case errors.Is(err, ErrMalformedProof):
+		return []string{"malformed_proof"}, true
+	case errors.Is(err, ErrInvalidProof):
+		return []string{"invalid_proof"}, true
+	default:
+		return nil, false
+	}
+}
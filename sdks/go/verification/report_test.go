@@ -0,0 +1,60 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestVerifyNamespaceReportClassifiesExpectedMismatch(t *testing.T) {
+	header := completeHeaderJSON("")
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`)
+
+	report := VerifyNamespaceReport(context.Background(), header, vidCommon, proof)
+	if report.Valid {
+		t.Fatal("expected an invalid report")
+	}
+	if report.Err != nil {
+		t.Fatalf("expected no Err for an expected mismatch, got %v", report.Err)
+	}
+	if len(report.FailedChecks) != 1 || report.FailedChecks[0] != "ns_table_lookup" {
+		t.Fatalf("got FailedChecks %v, want [ns_table_lookup]", report.FailedChecks)
+	}
+	if report.ProofSize != len(proof) {
+		t.Fatalf("got ProofSize %d, want %d", report.ProofSize, len(proof))
+	}
+}
+
+func TestVerifyNamespaceReportSurfacesSchemaError(t *testing.T) {
+	header := []byte(`{"height":1}`)
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`)
+
+	report := VerifyNamespaceReport(context.Background(), header, vidCommon, proof)
+	if report.Valid {
+		t.Fatal("expected an invalid report")
+	}
+	var schemaErr *SchemaError
+	if !errors.As(report.Err, &schemaErr) {
+		t.Fatalf("got Err %v, want *SchemaError", report.Err)
+	}
+	if len(report.FailedChecks) != 0 {
+		t.Fatalf("got FailedChecks %v, want none", report.FailedChecks)
+	}
+}
+
+func TestVerifyNamespaceReportSurfacesUnexpectedError(t *testing.T) {
+	report := VerifyNamespaceReport(context.Background(), nil, nil, nil)
+	if report.Valid {
+		t.Fatal("expected an invalid report")
+	}
+	if report.Err == nil {
+		t.Fatal("expected Err for malformed input")
+	}
+	if len(report.FailedChecks) != 0 {
+		t.Fatalf("got FailedChecks %v, want none", report.FailedChecks)
+	}
+}
@@ -0,0 +1,120 @@
+package verification
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// jsonSchema is the subset of JSON Schema this package's pre-validation
+// understands: which fields must be present, and what JSON type each known
+// field must have. It's a small hand-rolled subset rather than a full
+// implementation (no $ref, oneOf, nested object schemas, ...) because the
+// documents it validates - a sequencer header, a namespace proof - are
+// flat and few enough that "field present, field has the right top-level
+// type" already catches the mistakes that used to reach Rust as an opaque
+// serde error: a renamed field, a string where a number was expected, a
+// dropped field from an older client.
+type jsonSchema struct {
+	Required   []string                      `json:"required"`
+	Properties map[string]jsonSchemaProperty `json:"properties"`
+}
+
+type jsonSchemaProperty struct {
+	Type string `json:"type"`
+}
+
+// SchemaError is returned by the schema pre-checks ahead of the FFI call,
+// naming exactly which field was missing or wrong, instead of the opaque
+// string serde produces for the same mistake on the Rust side.
+type SchemaError struct {
+	Field  string
+	Reason string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("verification: field %q %s", e.Field, e.Reason)
+}
+
+var headerSchema, namespaceProofSchema jsonSchema
+
+func init() {
+	mustLoadSchema("schemas/header.schema.json", &headerSchema)
+	mustLoadSchema("schemas/namespace_proof.schema.json", &namespaceProofSchema)
+}
+
+func mustLoadSchema(path string, dst *jsonSchema) {
+	data, err := schemaFiles.ReadFile(path)
+	if err != nil {
+		panic(fmt.Sprintf("verification: embedded schema %s is missing: %v", path, err))
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		panic(fmt.Sprintf("verification: embedded schema %s is malformed: %v", path, err))
+	}
+}
+
+// validateSchema checks docJSON's top-level fields against schema. Map
+// iteration order is unspecified, so which of several violations gets
+// reported first isn't guaranteed when a document has more than one - but
+// that there is a violation, and which field it names, is.
+func validateSchema(schema jsonSchema, docJSON []byte) error {
+	var doc map[string]any
+	if err := json.Unmarshal(docJSON, &doc); err != nil {
+		return &SchemaError{Field: "$", Reason: "is not a JSON object"}
+	}
+	for _, field := range schema.Required {
+		if _, ok := doc[field]; !ok {
+			return &SchemaError{Field: field, Reason: "is missing"}
+		}
+	}
+	for field, prop := range schema.Properties {
+		val, ok := doc[field]
+		if !ok {
+			continue
+		}
+		if !schemaTypeMatches(val, prop.Type) {
+			return &SchemaError{Field: field, Reason: fmt.Sprintf("has the wrong type: want %s", prop.Type)}
+		}
+	}
+	return nil
+}
+
+func schemaTypeMatches(val any, want string) bool {
+	switch want {
+	case "integer":
+		n, ok := val.(float64)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := val.(float64)
+		return ok
+	case "string":
+		_, ok := val.(string)
+		return ok
+	case "array":
+		_, ok := val.([]any)
+		return ok
+	case "object":
+		_, ok := val.(map[string]any)
+		return ok
+	case "boolean":
+		_, ok := val.(bool)
+		return ok
+	default:
+		return true
+	}
+}
+
+// validateHeaderSchema checks headerJSON's shape against header.schema.json.
+func validateHeaderSchema(headerJSON []byte) error {
+	return validateSchema(headerSchema, headerJSON)
+}
+
+// validateNamespaceProofSchema checks proofJSON's shape against
+// namespace_proof.schema.json.
+func validateNamespaceProofSchema(proofJSON []byte) error {
+	return validateSchema(namespaceProofSchema, proofJSON)
+}
@@ -0,0 +1,46 @@
+package verification
+
+import "testing"
+
+func TestValidateHeaderSchemaRejectsMissingField(t *testing.T) {
+	err := validateHeaderSchema([]byte(`{"height":1}`))
+	if err == nil {
+		t.Fatal("expected error for missing required fields")
+	}
+	schemaErr, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatalf("got %T, want *SchemaError", err)
+	}
+	if schemaErr.Field != "ns_table" && schemaErr.Field != "block_merkle_tree_root" {
+		t.Fatalf("got field %q, want ns_table or block_merkle_tree_root", schemaErr.Field)
+	}
+}
+
+func TestValidateHeaderSchemaRejectsWrongType(t *testing.T) {
+	err := validateHeaderSchema([]byte(`{"height":"not-a-number","ns_table":"","block_merkle_tree_root":""}`))
+	schemaErr, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatalf("got %T, want *SchemaError", err)
+	}
+	if schemaErr.Field != "height" {
+		t.Fatalf("got field %q, want height", schemaErr.Field)
+	}
+}
+
+func TestValidateHeaderSchemaAcceptsWellFormedHeader(t *testing.T) {
+	header := `{"height":1,"timestamp":2,"ns_table":"AA==","block_merkle_tree_root":"root"}`
+	if err := validateHeaderSchema([]byte(header)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateNamespaceProofSchemaRejectsMissingField(t *testing.T) {
+	err := validateNamespaceProofSchema([]byte(`{"namespace":1}`))
+	schemaErr, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatalf("got %T, want *SchemaError", err)
+	}
+	if schemaErr.Field != "proof" && schemaErr.Field != "transactions" {
+		t.Fatalf("got field %q, want proof or transactions", schemaErr.Field)
+	}
+}
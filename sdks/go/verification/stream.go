@@ -0,0 +1,113 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#include <stddef.h>
+
+// verify_namespace_proof_from_file behaves like verify_namespace_proof
+// (see verify.go), except the proof JSON is read from the file at
+// proof_path instead of being passed in memory: the native side mmaps it
+// rather than requiring the caller to have the whole proof, which for a
+// multi-megabyte payload's worth of transactions can be copied into a
+// single cgo call, already resident in Go's heap.
+extern int verify_namespace_proof_from_file(
+	const unsigned char *header_json, size_t header_len,
+	const unsigned char *vid_common_json, size_t vid_common_len,
+	const unsigned char *proof_path, size_t proof_path_len,
+	unsigned char *out_diagnostics, size_t out_diagnostics_cap, size_t *out_diagnostics_len
+);
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"unsafe"
+)
+
+// VerifyNamespaceStream is the streaming counterpart to VerifyNamespace,
+// for proofs too large to comfortably hold in memory twice over (once in
+// whatever buffer the caller read it into, once more as the []byte handed
+// across the FFI boundary). proof is copied to a temp file in chunks via
+// io.Copy rather than buffered in a single []byte, and the native verifier
+// mmaps that file instead of taking the proof as an in-memory buffer.
+// headerJSON and vidCommonJSON are unaffected - they're small relative to
+// a namespace proof's transaction payload - and still passed as bytes, the
+// same as VerifyNamespace.
+//
+// The temp file is removed before VerifyNamespaceStream returns, success
+// or failure. See VerifyNamespace's doc comment for what ctx cancellation
+// does and doesn't interrupt.
+func VerifyNamespaceStream(ctx context.Context, headerJSON, vidCommonJSON []byte, proof io.Reader) error {
+	if libraryVersionErr != nil {
+		return libraryVersionErr
+	}
+	if err := validateJSONInput("header", headerJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return err
+	}
+	if err := validateHeaderSchema(headerJSON); err != nil {
+		return err
+	}
+	// The proof itself isn't schema-checked here: doing so would mean
+	// buffering it in full to unmarshal into a map, which is exactly what
+	// streaming it to a temp file below is trying to avoid. A malformed
+	// streamed proof is instead caught by the native verifier's own
+	// codeMalformedInput / codeMalformedProof result, at the cost of a
+	// less precise error than SchemaError gives VerifyNamespace.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "espresso-proof-*.json")
+	if err != nil {
+		return fmt.Errorf("verification: create temp file for proof: %w", err)
+	}
+	path := tmp.Name()
+	defer os.Remove(path)
+	defer tmp.Close()
+
+	n, err := io.Copy(tmp, io.LimitReader(proof, maxInputSize+1))
+	if err != nil {
+		return fmt.Errorf("verification: copy proof to temp file: %w", err)
+	}
+	if n > maxInputSize {
+		return fmt.Errorf("verification: proof exceeds %d byte limit", maxInputSize)
+	}
+	if n == 0 {
+		return fmt.Errorf("verification: proof is empty")
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("verification: flush proof temp file: %w", err)
+	}
+
+	pathBytes := []byte(path)
+
+	result := make(chan error, 1)
+	go func() {
+		var gerr error
+		defer func() { result <- gerr }()
+		defer recoverFFIPanic(&gerr)
+		diagnosticsBuf := make([]byte, diagnosticsBufCap)
+		var diagnosticsLen C.size_t
+		ret := C.verify_namespace_proof_from_file(
+			(*C.uchar)(unsafe.Pointer(&headerJSON[0])), C.size_t(len(headerJSON)),
+			(*C.uchar)(unsafe.Pointer(&vidCommonJSON[0])), C.size_t(len(vidCommonJSON)),
+			(*C.uchar)(unsafe.Pointer(&pathBytes[0])), C.size_t(len(pathBytes)),
+			(*C.uchar)(unsafe.Pointer(&diagnosticsBuf[0])), C.size_t(len(diagnosticsBuf)), &diagnosticsLen,
+		)
+		gerr = withDiagnostics(interpretResult(int32(ret)), diagnosticsBuf[:diagnosticsLen])
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,70 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+)
+
+type verifyNamespaceProofFromFileFunc func(headerJSON, vidCommonJSON, proofPath *byte, headerLen, vidCommonLen, proofPathLen uintptr, outDiagnostics *byte, outDiagnosticsCap uintptr, outDiagnosticsLen *uintptr) int32
+
+var verifyNamespaceProofFromFile verifyNamespaceProofFromFileFunc
+
+// VerifyNamespaceStream is the embedded-library counterpart to the
+// cgo-linked streaming verifier. See its doc comment.
+func VerifyNamespaceStream(ctx context.Context, headerJSON, vidCommonJSON []byte, proof io.Reader) (err error) {
+	if err := validateJSONInput("header", headerJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return err
+	}
+	if err := validateHeaderSchema(headerJSON); err != nil {
+		return err
+	}
+	// See the cgo VerifyNamespaceStream's comment: the proof itself isn't
+	// schema-checked here since that would require buffering it in full.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := loadEmbedded(); err != nil {
+		return err
+	}
+
+	tmp, terr := os.CreateTemp("", "espresso-proof-*.json")
+	if terr != nil {
+		return fmt.Errorf("verification: create temp file for proof: %w", terr)
+	}
+	path := tmp.Name()
+	defer os.Remove(path)
+	defer tmp.Close()
+
+	n, cerr := io.Copy(tmp, io.LimitReader(proof, maxInputSize+1))
+	if cerr != nil {
+		return fmt.Errorf("verification: copy proof to temp file: %w", cerr)
+	}
+	if n > maxInputSize {
+		return fmt.Errorf("verification: proof exceeds %d byte limit", maxInputSize)
+	}
+	if n == 0 {
+		return fmt.Errorf("verification: proof is empty")
+	}
+	if serr := tmp.Sync(); serr != nil {
+		return fmt.Errorf("verification: flush proof temp file: %w", serr)
+	}
+
+	pathBytes := []byte(path)
+	defer recoverFFIPanic(&err)
+
+	diagnosticsBuf := make([]byte, diagnosticsBufCap)
+	var diagnosticsLen uintptr
+	ret := verifyNamespaceProofFromFile(
+		&headerJSON[0], &vidCommonJSON[0], &pathBytes[0],
+		uintptr(len(headerJSON)), uintptr(len(vidCommonJSON)), uintptr(len(pathBytes)),
+		&diagnosticsBuf[0], uintptr(len(diagnosticsBuf)), &diagnosticsLen,
+	)
+	return withDiagnostics(interpretResult(ret), diagnosticsBuf[:diagnosticsLen])
+}
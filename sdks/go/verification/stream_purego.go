@@ -0,0 +1,32 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// VerifyNamespaceStream is the cgo-free counterpart to the streaming
+// verifier. Unlike the cgo and embedded builds, it has no native mmap path
+// to stream through, so it reads proof into memory in full (bounded by
+// maxInputSize) and delegates to the buffered structural check
+// VerifyNamespace already does under this build tag. It exists so callers
+// can write build-tag-independent code against VerifyNamespaceStream; it
+// does not get this build's memory-saving benefit.
+func VerifyNamespaceStream(ctx context.Context, headerJSON, vidCommonJSON []byte, proof io.Reader) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	proofJSON, err := io.ReadAll(io.LimitReader(proof, maxInputSize+1))
+	if err != nil {
+		return fmt.Errorf("verification: read proof: %w", err)
+	}
+	if len(proofJSON) > maxInputSize {
+		return fmt.Errorf("verification: proof exceeds %d byte limit", maxInputSize)
+	}
+
+	return VerifyNamespace(ctx, headerJSON, vidCommonJSON, proofJSON)
+}
@@ -0,0 +1,33 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestVerifyNamespaceStreamRejectsMissingNsTable(t *testing.T) {
+	header := []byte(`{"height":1}`)
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`)
+
+	var schemaErr *SchemaError
+	err := VerifyNamespaceStream(context.Background(), header, vidCommon, bytes.NewReader(proof))
+	if !errors.As(err, &schemaErr) {
+		t.Fatalf("got %v, want *SchemaError", err)
+	}
+}
+
+func TestVerifyNamespaceStreamRejectsEmptyNsTable(t *testing.T) {
+	header := completeHeaderJSON("")
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`)
+
+	err := VerifyNamespaceStream(context.Background(), header, vidCommon, bytes.NewReader(proof))
+	if !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("got %v, want ErrNamespaceMismatch", err)
+	}
+}
@@ -0,0 +1,44 @@
+package verification
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// maxInputSize bounds the size of any single JSON document passed across
+// the FFI boundary. Genuine headers, VID commons, and proofs are at most a
+// few KiB; anything near this bound is almost certainly a caller bug (e.g.
+// passing a whole block instead of its header) rather than a real proof,
+// and rejecting it here avoids handing an implausibly large buffer to cgo.
+const maxInputSize = 16 << 20 // 16 MiB
+
+// commitmentBufCap bounds the string-encoded commitment
+// ComputePayloadCommitment's native call can write. Commitments are short,
+// fixed-size hashes; this is comfortably larger than any encoding the
+// native verifier produces today.
+const commitmentBufCap = 256
+
+// diagnosticsBufCap bounds the JSON diagnostics object
+// verify_namespace_proof and verify_block_merkle_proof can write on
+// failure (see VerificationError). Generously larger than a
+// stage/expected/actual object encoding a couple of commitment strings
+// ever needs to be.
+const diagnosticsBufCap = 1024
+
+// validateJSONInput checks that b is non-empty, within maxInputSize, and
+// well-formed JSON before it crosses into C or a dlopen'd function, so
+// callers get a descriptive Go error instead of &b[0] panicking on an
+// empty slice or the native verifier choking on garbage bytes it can't
+// even parse enough to report a useful error for.
+func validateJSONInput(name string, b []byte) error {
+	if len(b) == 0 {
+		return fmt.Errorf("verification: %s is empty", name)
+	}
+	if len(b) > maxInputSize {
+		return fmt.Errorf("verification: %s is %d bytes, exceeds %d byte limit", name, len(b), maxInputSize)
+	}
+	if !json.Valid(b) {
+		return fmt.Errorf("verification: %s is not valid JSON", name)
+	}
+	return nil
+}
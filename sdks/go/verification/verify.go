@@ -0,0 +1,312 @@
+//go:build cgo && !noespressoffi && !embedded
+
+// Package verification wraps the Rust crypto-helper's FFI surface for
+// checking namespace and block merkle proofs returned by an Espresso query
+// service, so Go consumers don't have to trust a query node's say-so.
+//
+// Every exported function is safe to call concurrently from multiple
+// goroutines, including on the same inputs. The native functions this
+// package calls are pure: they take only buffers and scalars, hold no
+// handle or context across calls, and mutate no state shared between
+// calls, so there is nothing on the Rust side for concurrent calls to
+// race on. Go-side state - libraryVersionErr, the embedded build's
+// loadEmbedded, Pool, and the pooled result channels in this file - is
+// synchronized with sync.Once/sync.Pool/channels the same way any other
+// concurrent Go code would be. Nothing in this package adds a lock or
+// call-gate around the native calls themselves; if a future native
+// function is NOT re-entrant, it must document that explicitly and this
+// package must gate it.
+//
+// VerifyNamespace, VerifyMerkleProof, VerifyRewardAccountProof, and
+// VerifyNamespaceStream recover from a Go-side panic around their native
+// call rather than letting it crash the process (see recoverFFIPanic); the
+// batch, proof-generation, payload-commitment, merkle-proof-building, and
+// light-client calls do not. None of them can recover from the native
+// library itself aborting or segfaulting across the cgo boundary, which
+// remains a process-level failure outside this package's control.
+package verification
+
+/*
+// This LDFLAGS path is baked in at link time, not resolved when a binary
+// built with this package runs, so there's no runtime env var or option
+// that can redirect it the way SetLibraryPath and ESPRESSO_CRYPTO_LIB_PATH
+// do for the embedded (dlopen) build - see embed.go. Vendoring or Bazel
+// setups that need to place the library elsewhere should set the standard
+// CGO_LDFLAGS environment variable at build time instead, or use the
+// embedded build.
+#cgo LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#cgo windows LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#include <stddef.h>
+#include <stdlib.h>
+
+// verify_namespace_proof is implemented in the Rust crypto-helper crate
+// and exported via cbindgen. It returns 1 if the proof is valid, and one
+// of the negative codeXxx constants in errors.go describing why it's not
+// otherwise (see that file for the full contract, shared with the batch
+// and embedded verifiers). Lengths are size_t rather than unsigned long so
+// they match cbindgen's usize on every platform; unsigned long is 32 bits
+// on Windows and would truncate lengths over 4GiB there.
+//
+// On a non-codeValid return, it also writes a JSON object describing which
+// internal stage rejected the proof ("ns_table_lookup", "vid_check",
+// "commitment_comparison", or "merkle_path") and, for a mismatch, the
+// expected and actual commitments, into out_diagnostics, writing the
+// encoded length to *out_diagnostics_len. If out_diagnostics is too small
+// for the diagnostics it leaves *out_diagnostics_len at 0 rather than
+// truncating a value Go might otherwise parse as valid JSON.
+extern int verify_namespace_proof(
+	const unsigned char *header_json, size_t header_len,
+	const unsigned char *vid_common_json, size_t vid_common_len,
+	const unsigned char *proof_json, size_t proof_len,
+	unsigned char *out_diagnostics, size_t out_diagnostics_cap, size_t *out_diagnostics_len
+);
+
+// verify_block_merkle_proof writes the same diagnostics shape as
+// verify_namespace_proof on failure; its only possible stage is
+// "merkle_path", since the commitment comparison itself happens in Go
+// before this is ever called (see VerifyMerkleProof).
+extern int verify_block_merkle_proof(
+	const unsigned char *header_json, size_t header_len,
+	const unsigned char *proof_json, size_t proof_len,
+	unsigned char *out_diagnostics, size_t out_diagnostics_cap, size_t *out_diagnostics_len
+);
+
+// verify_reward_merkle_proof checks a types.RewardAccountProof the same way
+// verify_block_merkle_proof checks a types.BlockMerkleProof: the same
+// diagnostics shape, and the only possible stage is "merkle_path", since
+// the commitment comparison happens in Go before this is called (see
+// VerifyRewardAccountProof).
+extern int verify_reward_merkle_proof(
+	const unsigned char *header_json, size_t header_len,
+	const unsigned char *proof_json, size_t proof_len,
+	unsigned char *out_diagnostics, size_t out_diagnostics_cap, size_t *out_diagnostics_len
+);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// VerifyNamespace checks that proofJSON attests to the transactions it
+// carries being the complete set of transactions for a namespace in the
+// block described by headerJSON and vidCommonJSON. All three arguments are
+// the exact JSON bytes returned by the query service; re-serializing them
+// can change field order and invalidate the commitment check.
+//
+// ctx is checked before the call starts and raced against it once it's in
+// flight; if ctx is done first, VerifyNamespace returns ctx.Err() without
+// waiting for the native call. The native call itself is not interruptible
+// across the FFI boundary, so it keeps running in the background until it
+// finishes - callers relying on cancellation to free resources promptly
+// should still bound how many verifications they have in flight, e.g. with
+// Pool.
+//
+// This is the hot path for a rollup verifying every block it sees, almost
+// always with context.Background(): it skips the goroutine and channel
+// entirely when ctx can't be cancelled, and otherwise reuses a pooled
+// result channel instead of allocating one per call. headerJSON,
+// vidCommonJSON, and proofJSON are read directly via unsafe.Pointer rather
+// than copied, and interpretResult only ever inspects an int32 result
+// code, so there's no C string marshaling on the success path either.
+func VerifyNamespace(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) (err error) {
+	if libraryVersionErr != nil {
+		return libraryVersionErr
+	}
+	if err := validateJSONInput("header", headerJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("proof", proofJSON); err != nil {
+		return err
+	}
+	if err := validateHeaderSchema(headerJSON); err != nil {
+		return err
+	}
+	if err := validateNamespaceProofSchema(proofJSON); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if ctx.Done() == nil {
+		defer recoverFFIPanic(&err)
+		diagnosticsBuf := make([]byte, diagnosticsBufCap)
+		var diagnosticsLen C.size_t
+		ret := C.verify_namespace_proof(
+			(*C.uchar)(unsafe.Pointer(&headerJSON[0])), C.size_t(len(headerJSON)),
+			(*C.uchar)(unsafe.Pointer(&vidCommonJSON[0])), C.size_t(len(vidCommonJSON)),
+			(*C.uchar)(unsafe.Pointer(&proofJSON[0])), C.size_t(len(proofJSON)),
+			(*C.uchar)(unsafe.Pointer(&diagnosticsBuf[0])), C.size_t(len(diagnosticsBuf)), &diagnosticsLen,
+		)
+		return withDiagnostics(interpretResult(int32(ret)), diagnosticsBuf[:diagnosticsLen])
+	}
+
+	result := verifyNamespaceResultPool.Get().(chan error)
+	go func() {
+		var gerr error
+		defer func() { result <- gerr }()
+		defer recoverFFIPanic(&gerr)
+		diagnosticsBuf := make([]byte, diagnosticsBufCap)
+		var diagnosticsLen C.size_t
+		ret := C.verify_namespace_proof(
+			(*C.uchar)(unsafe.Pointer(&headerJSON[0])), C.size_t(len(headerJSON)),
+			(*C.uchar)(unsafe.Pointer(&vidCommonJSON[0])), C.size_t(len(vidCommonJSON)),
+			(*C.uchar)(unsafe.Pointer(&proofJSON[0])), C.size_t(len(proofJSON)),
+			(*C.uchar)(unsafe.Pointer(&diagnosticsBuf[0])), C.size_t(len(diagnosticsBuf)), &diagnosticsLen,
+		)
+		gerr = withDiagnostics(interpretResult(int32(ret)), diagnosticsBuf[:diagnosticsLen])
+	}()
+
+	select {
+	case err := <-result:
+		// Safe to reuse: we know the one value the goroutine sends has
+		// already been drained, so the channel is empty again.
+		verifyNamespaceResultPool.Put(result)
+		return err
+	case <-ctx.Done():
+		// The goroutine above is still running and will write to result
+		// once the native call returns; pooling it now would let a future
+		// caller receive that stale write instead of its own result. Let
+		// it be garbage collected once the goroutine exits instead.
+		return ctx.Err()
+	}
+}
+
+// verifyNamespaceResultPool holds reusable result channels for
+// VerifyNamespace's cancelable-context path, so repeated calls don't each
+// allocate a fresh channel.
+var verifyNamespaceResultPool = sync.Pool{
+	New: func() any { return make(chan error, 1) },
+}
+
+// VerifyMerkleProof checks that proof attests to header's inclusion in the
+// block merkle tree rooted at commitment. header and proof are serialized
+// internally rather than accepted as caller-supplied bytes, so there's one
+// canonical encoding of each and no risk of a caller's re-serialized
+// header silently drifting from the bytes the commitment was computed
+// over. commitment must equal header.BlockMerkleRoot; passing the two
+// separately, rather than trusting the header's own field, lets callers
+// check the header against a commitment they already trust (e.g. from a
+// light client) instead of just checking it against itself.
+//
+// See VerifyNamespace's doc comment for what ctx cancellation does and
+// doesn't interrupt.
+func VerifyMerkleProof(ctx context.Context, header types.HeaderImpl, proof types.BlockMerkleProof, commitment types.Commitment) error {
+	if libraryVersionErr != nil {
+		return libraryVersionErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if types.Commitment(header.BlockMerkleRoot) != commitment {
+		return &VerificationError{
+			Stage:    "commitment_comparison",
+			Expected: string(commitment),
+			Actual:   header.BlockMerkleRoot,
+			Err:      ErrCommitmentMismatch,
+		}
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return fmt.Errorf("verification: encode header: %w", err)
+	}
+	proofJSON, err := json.Marshal(proof)
+	if err != nil {
+		return fmt.Errorf("verification: encode proof: %w", err)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		var gerr error
+		defer func() { result <- gerr }()
+		defer recoverFFIPanic(&gerr)
+		diagnosticsBuf := make([]byte, diagnosticsBufCap)
+		var diagnosticsLen C.size_t
+		ret := C.verify_block_merkle_proof(
+			(*C.uchar)(unsafe.Pointer(&headerJSON[0])), C.size_t(len(headerJSON)),
+			(*C.uchar)(unsafe.Pointer(&proofJSON[0])), C.size_t(len(proofJSON)),
+			(*C.uchar)(unsafe.Pointer(&diagnosticsBuf[0])), C.size_t(len(diagnosticsBuf)), &diagnosticsLen,
+		)
+		gerr = withDiagnostics(interpretResult(int32(ret)), diagnosticsBuf[:diagnosticsLen])
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// VerifyRewardAccountProof checks that proof attests to its account's
+// inclusion in the reward merkle tree rooted at commitment. header must be
+// HeaderVersionV3 - see EpochHeaderFields' doc comment for why reward
+// accounting only exists from that version on - and commitment must equal
+// header.EpochFields().RewardMerkleRoot; as with VerifyMerkleProof, passing
+// the two separately rather than trusting the header's own field lets
+// callers check the header against a commitment they already trust.
+//
+// See VerifyNamespace's doc comment for what ctx cancellation does and
+// doesn't interrupt.
+func VerifyRewardAccountProof(ctx context.Context, header types.HeaderImpl, proof types.RewardAccountProof, commitment types.Commitment) error {
+	if libraryVersionErr != nil {
+		return libraryVersionErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	epoch, ok := header.EpochFields()
+	if !ok {
+		return &VerificationError{Stage: "commitment_comparison", Err: ErrEpochFieldsMissing}
+	}
+	if types.Commitment(epoch.RewardMerkleRoot) != commitment {
+		return &VerificationError{
+			Stage:    "commitment_comparison",
+			Expected: string(commitment),
+			Actual:   epoch.RewardMerkleRoot,
+			Err:      ErrCommitmentMismatch,
+		}
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return fmt.Errorf("verification: encode header: %w", err)
+	}
+	proofJSON, err := json.Marshal(proof)
+	if err != nil {
+		return fmt.Errorf("verification: encode proof: %w", err)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		var gerr error
+		defer func() { result <- gerr }()
+		defer recoverFFIPanic(&gerr)
+		diagnosticsBuf := make([]byte, diagnosticsBufCap)
+		var diagnosticsLen C.size_t
+		ret := C.verify_reward_merkle_proof(
+			(*C.uchar)(unsafe.Pointer(&headerJSON[0])), C.size_t(len(headerJSON)),
+			(*C.uchar)(unsafe.Pointer(&proofJSON[0])), C.size_t(len(proofJSON)),
+			(*C.uchar)(unsafe.Pointer(&diagnosticsBuf[0])), C.size_t(len(diagnosticsBuf)), &diagnosticsLen,
+		)
+		gerr = withDiagnostics(interpretResult(int32(ret)), diagnosticsBuf[:diagnosticsLen])
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
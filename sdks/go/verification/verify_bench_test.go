@@ -0,0 +1,38 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkVerifyNamespace exercises the cancelable-context path (a
+// context with a deadline) against the hot, non-cancelable path
+// (context.Background()) side by side, so a regression in either one's
+// allocation count shows up in `go test -bench . -benchmem`. The inputs
+// pass the Go-side schema checks but are placeholders, so both fail inside
+// the native call; the point is the allocation profile up to and around
+// that call, not the verification result.
+func BenchmarkVerifyNamespace(b *testing.B) {
+	header := []byte(`{"height":1,"ns_table":"AA==","block_merkle_tree_root":"root"}`)
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[]}`)
+
+	b.Run("background", func(b *testing.B) {
+		ctx := context.Background()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = VerifyNamespace(ctx, header, vidCommon, proof)
+		}
+	})
+
+	b.Run("cancelable", func(b *testing.B) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = VerifyNamespace(ctx, header, vidCommon, proof)
+		}
+	})
+}
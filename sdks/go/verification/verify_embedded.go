@@ -0,0 +1,126 @@
+//go:build embedded && !noespressoffi
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// VerifyNamespace is the embedded-library counterpart to the cgo-linked
+// verifier: it loads libespresso_crypto_helper from this binary's own
+// embedded copy via dlopen instead of requiring LDFLAGS and a
+// separately-installed library. See lib/README.md for how that binary gets
+// there.
+func VerifyNamespace(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateJSONInput("header", headerJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("proof", proofJSON); err != nil {
+		return err
+	}
+	if err := validateHeaderSchema(headerJSON); err != nil {
+		return err
+	}
+	if err := validateNamespaceProofSchema(proofJSON); err != nil {
+		return err
+	}
+	if err := loadEmbedded(); err != nil {
+		return err
+	}
+	defer recoverFFIPanic(&err)
+
+	diagnosticsBuf := make([]byte, diagnosticsBufCap)
+	var diagnosticsLen uintptr
+	ret := verifyNamespaceProof(
+		&headerJSON[0], &vidCommonJSON[0], &proofJSON[0],
+		uintptr(len(headerJSON)), uintptr(len(vidCommonJSON)), uintptr(len(proofJSON)),
+		&diagnosticsBuf[0], uintptr(len(diagnosticsBuf)), &diagnosticsLen,
+	)
+	return withDiagnostics(interpretResult(ret), diagnosticsBuf[:diagnosticsLen])
+}
+
+// VerifyMerkleProof is the embedded-library counterpart to the cgo-linked
+// block merkle proof verifier. See its doc comment for why header and
+// proof are typed structs serialized internally instead of caller-supplied
+// bytes.
+func VerifyMerkleProof(ctx context.Context, header types.HeaderImpl, proof types.BlockMerkleProof, commitment types.Commitment) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if types.Commitment(header.BlockMerkleRoot) != commitment {
+		return &VerificationError{
+			Stage:    "commitment_comparison",
+			Expected: string(commitment),
+			Actual:   header.BlockMerkleRoot,
+			Err:      ErrCommitmentMismatch,
+		}
+	}
+	if err := loadEmbedded(); err != nil {
+		return err
+	}
+
+	headerJSON, merr := json.Marshal(header)
+	if merr != nil {
+		return fmt.Errorf("verification: encode header: %w", merr)
+	}
+	proofJSON, merr := json.Marshal(proof)
+	if merr != nil {
+		return fmt.Errorf("verification: encode proof: %w", merr)
+	}
+	defer recoverFFIPanic(&err)
+
+	diagnosticsBuf := make([]byte, diagnosticsBufCap)
+	var diagnosticsLen uintptr
+	ret := verifyBlockMerkleProof(&headerJSON[0], &proofJSON[0], uintptr(len(headerJSON)), uintptr(len(proofJSON)), &diagnosticsBuf[0], uintptr(len(diagnosticsBuf)), &diagnosticsLen)
+	return withDiagnostics(interpretResult(ret), diagnosticsBuf[:diagnosticsLen])
+}
+
+// VerifyRewardAccountProof is the embedded-library counterpart to the
+// cgo-linked reward merkle proof verifier. See its doc comment for why
+// header and proof are typed structs serialized internally instead of
+// caller-supplied bytes.
+func VerifyRewardAccountProof(ctx context.Context, header types.HeaderImpl, proof types.RewardAccountProof, commitment types.Commitment) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	epoch, ok := header.EpochFields()
+	if !ok {
+		return &VerificationError{Stage: "commitment_comparison", Err: ErrEpochFieldsMissing}
+	}
+	if types.Commitment(epoch.RewardMerkleRoot) != commitment {
+		return &VerificationError{
+			Stage:    "commitment_comparison",
+			Expected: string(commitment),
+			Actual:   epoch.RewardMerkleRoot,
+			Err:      ErrCommitmentMismatch,
+		}
+	}
+	if err := loadEmbedded(); err != nil {
+		return err
+	}
+
+	headerJSON, merr := json.Marshal(header)
+	if merr != nil {
+		return fmt.Errorf("verification: encode header: %w", merr)
+	}
+	proofJSON, merr := json.Marshal(proof)
+	if merr != nil {
+		return fmt.Errorf("verification: encode proof: %w", merr)
+	}
+	defer recoverFFIPanic(&err)
+
+	diagnosticsBuf := make([]byte, diagnosticsBufCap)
+	var diagnosticsLen uintptr
+	ret := verifyRewardMerkleProof(&headerJSON[0], &proofJSON[0], uintptr(len(headerJSON)), uintptr(len(proofJSON)), &diagnosticsBuf[0], uintptr(len(diagnosticsBuf)), &diagnosticsLen)
+	return withDiagnostics(interpretResult(ret), diagnosticsBuf[:diagnosticsLen])
+}
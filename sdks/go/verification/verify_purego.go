@@ -0,0 +1,101 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// VerifyNamespace is the cgo-free counterpart to the native verifier, for
+// environments where the Rust crypto-helper can't be linked: cross-compiled
+// binaries, WASM targets, or CGO_ENABLED=0 builds. It checks only that the
+// inputs pass the same JSON and schema validation as the native build, that
+// the header's ns_table is non-empty, and that the proof carries a non-empty
+// proof field and at least one transaction. It does not hash the
+// transactions, compare them against any commitment, use vidCommonJSON, or
+// check the merkle path. Prefer the cgo build when it's available; use this as a fallback,
+// not a security-equivalent substitute.
+func VerifyNamespace(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateJSONInput("header", headerJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("vid_common", vidCommonJSON); err != nil {
+		return err
+	}
+	if err := validateJSONInput("proof", proofJSON); err != nil {
+		return err
+	}
+	if err := validateHeaderSchema(headerJSON); err != nil {
+		return err
+	}
+	if err := validateNamespaceProofSchema(proofJSON); err != nil {
+		return err
+	}
+
+	var header types.HeaderImpl
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return errors.New("verification: malformed header")
+	}
+
+	var proof types.NamespaceProof
+	if err := json.Unmarshal(proofJSON, &proof); err != nil {
+		return errors.New("verification: malformed proof")
+	}
+
+	if header.NsTable == "" {
+		return &VerificationError{Stage: "ns_table_lookup", Err: ErrNamespaceMismatch}
+	}
+	if len(proof.Proof) == 0 || len(proof.Transactions) == 0 {
+		return &VerificationError{Stage: "merkle_path", Err: ErrMalformedProof}
+	}
+	return nil
+}
+
+// VerifyMerkleProof is the cgo-free counterpart to the native block merkle
+// proof verifier. See VerifyNamespace's doc comment for the scope of what
+// this fallback actually checks, and the cgo VerifyMerkleProof's doc
+// comment for why header and proof are typed structs rather than bytes.
+func VerifyMerkleProof(ctx context.Context, header types.HeaderImpl, proof types.BlockMerkleProof, commitment types.Commitment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if header.BlockMerkleRoot == "" || types.Commitment(header.BlockMerkleRoot) != commitment {
+		return &VerificationError{
+			Stage:    "commitment_comparison",
+			Expected: string(commitment),
+			Actual:   header.BlockMerkleRoot,
+			Err:      ErrCommitmentMismatch,
+		}
+	}
+	return nil
+}
+
+// VerifyRewardAccountProof is the cgo-free counterpart to the native reward
+// merkle proof verifier. See VerifyNamespace's doc comment for the scope of
+// what this fallback actually checks, and the cgo VerifyRewardAccountProof's
+// doc comment for why header and proof are typed structs rather than bytes.
+func VerifyRewardAccountProof(ctx context.Context, header types.HeaderImpl, proof types.RewardAccountProof, commitment types.Commitment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	epoch, ok := header.EpochFields()
+	if !ok {
+		return &VerificationError{Stage: "commitment_comparison", Err: ErrEpochFieldsMissing}
+	}
+	if epoch.RewardMerkleRoot == "" || types.Commitment(epoch.RewardMerkleRoot) != commitment {
+		return &VerificationError{
+			Stage:    "commitment_comparison",
+			Expected: string(commitment),
+			Actual:   epoch.RewardMerkleRoot,
+			Err:      ErrCommitmentMismatch,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+//go:build !cgo && !noespressoffi && !embedded
+
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestVerifyNamespaceRejectsEmptyInput(t *testing.T) {
+	if err := VerifyNamespace(context.Background(), nil, nil, nil); err == nil {
+		t.Fatal("expected error for empty input")
+	}
+}
+
+func TestVerifyNamespaceRejectsMissingNsTable(t *testing.T) {
+	header := []byte(`{"height":1}`)
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`)
+
+	var schemaErr *SchemaError
+	if err := VerifyNamespace(context.Background(), header, vidCommon, proof); !errors.As(err, &schemaErr) {
+		t.Fatalf("got %v, want *SchemaError", err)
+	}
+}
+
+func TestVerifyNamespaceRejectsEmptyNsTable(t *testing.T) {
+	header := completeHeaderJSON("")
+	vidCommon := []byte(`"AQ=="`)
+	proof := []byte(`{"namespace":1,"proof":"AQ==","transactions":[{"namespace":1,"payload":"AQ=="}]}`)
+
+	if err := VerifyNamespace(context.Background(), header, vidCommon, proof); !errors.Is(err, ErrNamespaceMismatch) {
+		t.Fatalf("got %v, want ErrNamespaceMismatch", err)
+	}
+}
+
+func TestVerifyMerkleProofRejectsMissingRoot(t *testing.T) {
+	header := types.HeaderImpl{Height: 1}
+	proof := types.BlockMerkleProof{Height: 1}
+
+	if err := VerifyMerkleProof(context.Background(), header, proof, ""); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("got %v, want ErrCommitmentMismatch", err)
+	}
+}
+
+func TestVerifyMerkleProofRejectsCommitmentMismatch(t *testing.T) {
+	header := types.HeaderImpl{Height: 1, BlockMerkleRoot: "root-a"}
+	proof := types.BlockMerkleProof{Height: 1}
+
+	if err := VerifyMerkleProof(context.Background(), header, proof, "root-b"); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("got %v, want ErrCommitmentMismatch", err)
+	}
+}
+
+func TestVerifyRewardAccountProofRejectsMissingEpochFields(t *testing.T) {
+	header := types.HeaderImpl{Height: 1}
+	proof := types.RewardAccountProof{}
+
+	if err := VerifyRewardAccountProof(context.Background(), header, proof, "root-a"); !errors.Is(err, ErrEpochFieldsMissing) {
+		t.Fatalf("got %v, want ErrEpochFieldsMissing", err)
+	}
+}
+
+func TestVerifyRewardAccountProofRejectsCommitmentMismatch(t *testing.T) {
+	header := types.HeaderImpl{Height: 1, Epoch: &types.EpochHeaderFields{RewardMerkleRoot: "root-a"}}
+	proof := types.RewardAccountProof{}
+
+	if err := VerifyRewardAccountProof(context.Background(), header, proof, "root-b"); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("got %v, want ErrCommitmentMismatch", err)
+	}
+}
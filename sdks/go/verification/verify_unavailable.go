@@ -0,0 +1,92 @@
+//go:build noespressoffi
+
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+// ErrVerificationUnavailable is returned by every verify and generate
+// function when built with the noespressoffi tag, which compiles this
+// package without cgo so downstream modules that only use the client
+// package can build on platforms without the native crypto-helper library.
+var ErrVerificationUnavailable = errors.New("verification: built with noespressoffi, native verifier unavailable")
+
+// VerifyNamespace always returns ErrVerificationUnavailable under
+// noespressoffi. Build without that tag, or use the cgo-free fallback
+// (plain `!cgo` build, no tag) for an approximate check instead.
+func VerifyNamespace(ctx context.Context, headerJSON, vidCommonJSON, proofJSON []byte) error {
+	return ErrVerificationUnavailable
+}
+
+// VerifyMerkleProof always returns ErrVerificationUnavailable under
+// noespressoffi. See VerifyNamespace's doc comment.
+func VerifyMerkleProof(ctx context.Context, header types.HeaderImpl, proof types.BlockMerkleProof, commitment types.Commitment) error {
+	return ErrVerificationUnavailable
+}
+
+// VerifyRewardAccountProof always returns ErrVerificationUnavailable under
+// noespressoffi. See VerifyNamespace's doc comment.
+func VerifyRewardAccountProof(ctx context.Context, header types.HeaderImpl, proof types.RewardAccountProof, commitment types.Commitment) error {
+	return ErrVerificationUnavailable
+}
+
+// GenerateNamespaceProof always returns ErrVerificationUnavailable under
+// noespressoffi. See VerifyNamespace's doc comment.
+func GenerateNamespaceProof(ctx context.Context, payload, nsTableJSON, vidCommonJSON []byte, namespace types.NamespaceId) (json.RawMessage, error) {
+	return nil, ErrVerificationUnavailable
+}
+
+// BuildBlockMerkleProof always returns ErrVerificationUnavailable under
+// noespressoffi. See VerifyNamespace's doc comment.
+func BuildBlockMerkleProof(ctx context.Context, leafCommitments []types.Commitment, startHeight, targetHeight uint64) (types.BlockMerkleProof, error) {
+	return types.BlockMerkleProof{}, ErrVerificationUnavailable
+}
+
+// ComputePayloadCommitment always returns ErrVerificationUnavailable under
+// noespressoffi. See VerifyNamespace's doc comment.
+func ComputePayloadCommitment(ctx context.Context, payload, vidCommonJSON []byte) (types.Commitment, error) {
+	return "", ErrVerificationUnavailable
+}
+
+// NamespaceProofInput is one request to VerifyNamespaceBatch: the same
+// three byte-exact JSON documents VerifyNamespace takes individually.
+type NamespaceProofInput struct {
+	HeaderJSON    []byte
+	VidCommonJSON []byte
+	ProofJSON     []byte
+}
+
+// Result is one entry of VerifyNamespaceBatch's output, in the same order
+// as the inputs.
+type Result struct {
+	Err error
+}
+
+// VerifyNamespaceBatch always returns ErrVerificationUnavailable for every
+// input under noespressoffi.
+func VerifyNamespaceBatch(ctx context.Context, inputs []NamespaceProofInput) ([]Result, error) {
+	results := make([]Result, len(inputs))
+	for i := range inputs {
+		results[i] = Result{Err: ErrVerificationUnavailable}
+	}
+	return results, nil
+}
+
+// VerifyLightClientStateSignature always returns
+// ErrVerificationUnavailable under noespressoffi. See VerifyNamespace's
+// doc comment.
+func VerifyLightClientStateSignature(ctx context.Context, state types.LightClientState, signatures []types.StateSignature) error {
+	return ErrVerificationUnavailable
+}
+
+// VerifyNamespaceStream always returns ErrVerificationUnavailable under
+// noespressoffi. See VerifyNamespace's doc comment.
+func VerifyNamespaceStream(ctx context.Context, headerJSON, vidCommonJSON []byte, proof io.Reader) error {
+	return ErrVerificationUnavailable
+}
@@ -0,0 +1,28 @@
+//go:build noespressoffi
+
+package verification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/socialsister/espresso-sequencer/sdks/go/types"
+)
+
+func TestVerifyNamespaceUnavailableUnderNoFFI(t *testing.T) {
+	if err := VerifyNamespace(context.Background(), nil, nil, nil); err != ErrVerificationUnavailable {
+		t.Fatalf("got %v, want ErrVerificationUnavailable", err)
+	}
+}
+
+func TestVerifyMerkleProofUnavailableUnderNoFFI(t *testing.T) {
+	if err := VerifyMerkleProof(context.Background(), types.HeaderImpl{}, types.BlockMerkleProof{}, ""); err != ErrVerificationUnavailable {
+		t.Fatalf("got %v, want ErrVerificationUnavailable", err)
+	}
+}
+
+func TestVerifyRewardAccountProofUnavailableUnderNoFFI(t *testing.T) {
+	if err := VerifyRewardAccountProof(context.Background(), types.HeaderImpl{}, types.RewardAccountProof{}, ""); err != ErrVerificationUnavailable {
+		t.Fatalf("got %v, want ErrVerificationUnavailable", err)
+	}
+}
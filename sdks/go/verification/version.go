@@ -0,0 +1,31 @@
+package verification
+
+import (
+	"fmt"
+	"strings"
+)
+
+// expectedLibraryMajorVersion is the major version component of
+// libespresso_crypto_helper these Go bindings were written against. The
+// native FFI surface (function signatures, the codeXxx contract in
+// errors.go) is only guaranteed stable within a major version, so a
+// mismatch here means this SDK and the linked library speak different FFI
+// versions, not just that an upgrade is available.
+const expectedLibraryMajorVersion = "0"
+
+// checkLibraryVersion compares a linked library's self-reported version
+// against expectedLibraryMajorVersion and returns a descriptive error on a
+// major-version mismatch, instead of letting stale bindings fail later with
+// a cryptic deserialization error deep inside an otherwise valid-looking
+// cgo call.
+func checkLibraryVersion(version string) error {
+	major, _, ok := strings.Cut(version, ".")
+	if !ok {
+		return fmt.Errorf("verification: linked library reports malformed version %q", version)
+	}
+	if major != expectedLibraryMajorVersion {
+		return fmt.Errorf("verification: linked library version %q is incompatible with these bindings (expected major version %s.x)",
+			version, expectedLibraryMajorVersion)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+//go:build cgo && !noespressoffi && !embedded
+
+package verification
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+#cgo windows LDFLAGS: -L${SRCDIR}/target/lib -lespresso_crypto_helper
+
+// crypto_helper_version returns a NUL-terminated, statically-allocated
+// string naming the linked library's version (e.g. "0.2.3"). The caller
+// must not free it.
+extern const char *crypto_helper_version(void);
+*/
+import "C"
+
+// libraryVersionErr is the result of checking the linked library's version
+// against expectedLibraryMajorVersion, computed once at package init since
+// the cgo-linked library is always available - there's no dlopen step to
+// defer the check past, unlike the embedded build's loadEmbedded. Every
+// exported function in this build checks it first.
+var libraryVersionErr = checkLibraryVersion(C.GoString(C.crypto_helper_version()))
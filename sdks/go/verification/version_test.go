@@ -0,0 +1,21 @@
+package verification
+
+import "testing"
+
+func TestCheckLibraryVersionAcceptsMatchingMajor(t *testing.T) {
+	if err := checkLibraryVersion(expectedLibraryMajorVersion + ".5.1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckLibraryVersionRejectsMismatchedMajor(t *testing.T) {
+	if err := checkLibraryVersion("99.0.0"); err == nil {
+		t.Fatal("expected error for mismatched major version")
+	}
+}
+
+func TestCheckLibraryVersionRejectsMalformedVersion(t *testing.T) {
+	if err := checkLibraryVersion("not-a-version"); err == nil {
+		t.Fatal("expected error for malformed version")
+	}
+}